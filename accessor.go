@@ -0,0 +1,185 @@
+package jsonpointer
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// accessorOp identifies the operation performed by one step of an accessor plan.
+type accessorOp uint8
+
+const (
+	// opDeref follows a non-nil pointer.
+	opDeref accessorOp = iota
+	// opField selects a struct field by index.
+	opField
+	// opIndex selects a slice or array element.
+	opIndex
+	// opKey selects a map entry.
+	opKey
+	// opDynamic resolves the remaining path with get, for values whose type is
+	// only known at runtime or which customize their own traversal.
+	opDynamic
+)
+
+// accessorStep is one precomputed step of an accessor plan.
+type accessorStep struct {
+	op    accessorOp
+	index int           // field index for opField, element index for opIndex
+	key   reflect.Value // map key for opKey, converted to the map's key type
+	rest  Path          // remaining path for opDynamic
+}
+
+var (
+	fieldAccessorType = reflect.TypeFor[FieldAccessor]()
+	sequenceType      = reflect.TypeFor[Sequence]()
+	lazyValueType     = reflect.TypeFor[LazyValue]()
+	rootType          = reflect.TypeFor[Root]()
+	rawMessageType    = reflect.TypeFor[json.RawMessage]()
+)
+
+// CompileAccessor compiles pointer against the static type T into a function
+// reading the pointed-to value from any *T. Struct field indices, map keys and
+// element indices are looked up once here, so each call only walks the
+// precomputed steps, which makes it suited to reading the same pointer from many
+// values of the same type.
+//
+// The accessor returns the same values and errors as Get on the *T. Pointers that
+// can never resolve against T, such as an unknown struct field, a malformed index
+// or a component below a scalar, are reported here instead, wrapping the error Get
+// would return. Below interface-typed values, and values implementing
+// FieldAccessor, Sequence or LazyValue, the remaining path is resolved as Get does.
+func CompileAccessor[T any](pointer string) (func(*T) (any, error), error) {
+	if err := validatePointerString(pointer); err != nil {
+		return nil, err
+	}
+	path := parseJsonPointer(pointer)
+
+	// Root documents resolve their first component themselves
+	if reflect.TypeFor[*T]().Implements(rootType) {
+		return func(doc *T) (any, error) {
+			return Get(doc, path...)
+		}, nil
+	}
+
+	steps, err := compileAccessorSteps(reflect.TypeFor[*T](), path)
+	if err != nil {
+		return nil, fmt.Errorf("compile accessor %q: %w", pointer, err)
+	}
+
+	return func(doc *T) (any, error) {
+		if len(path) == 0 {
+			return doc, nil
+		}
+		return runAccessor(reflect.ValueOf(doc), steps, path)
+	}, nil
+}
+
+// compileAccessorSteps plans the traversal of path starting from a value of type t.
+func compileAccessorSteps(t reflect.Type, path Path) ([]accessorStep, error) {
+	steps := make([]accessorStep, 0, len(path)+1)
+	for i := 0; i < len(path); i++ {
+		key := path[i]
+
+		if isDynamicType(t) {
+			return append(steps, accessorStep{op: opDynamic, rest: path[i:]}), nil
+		}
+		if t.Kind() == reflect.Ptr {
+			steps = append(steps, accessorStep{op: opDeref})
+			t = t.Elem()
+			i-- // Resolve the same component against the pointed-to type
+			continue
+		}
+
+		switch t.Kind() {
+		case reflect.Struct:
+			index, ok := getStructFields(t)[key]
+			if !ok {
+				return nil, ErrFieldNotFound
+			}
+			steps = append(steps, accessorStep{op: opField, index: index})
+			t = t.Field(index).Type
+
+		case reflect.Map:
+			if t.Key().Kind() != reflect.String {
+				return nil, ErrNotFound
+			}
+			mapKey := reflect.ValueOf(key).Convert(t.Key())
+			steps = append(steps, accessorStep{op: opKey, key: mapKey})
+			t = t.Elem()
+
+		case reflect.Slice, reflect.Array:
+			if key == "-" {
+				if i < len(path)-1 {
+					return nil, ErrArrayEndNotFinal
+				}
+				return nil, ErrIndexOutOfBounds // "-" refers to nonexistent element
+			}
+			index := fastAtoi(key)
+			if index < 0 || strconv.Itoa(index) != key {
+				return nil, ErrInvalidIndex
+			}
+			if t.Kind() == reflect.Array && index >= t.Len() {
+				return nil, ErrIndexOutOfBounds
+			}
+			steps = append(steps, accessorStep{op: opIndex, index: index})
+			t = t.Elem()
+
+		case reflect.Invalid, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+			reflect.Chan, reflect.Func, reflect.Interface, reflect.Ptr, reflect.String, reflect.UnsafePointer:
+			// Scalars cannot be traversed; interfaces and pointers were handled above
+			return nil, ErrNotFound
+		}
+	}
+	return steps, nil
+}
+
+// isDynamicType reports whether values of type t must be traversed by get because
+// their content is only known at runtime or they customize their own traversal.
+func isDynamicType(t reflect.Type) bool {
+	return t.Kind() == reflect.Interface ||
+		t == rawMessageType ||
+		t.Implements(fieldAccessorType) ||
+		t.Implements(sequenceType) ||
+		t.Implements(lazyValueType)
+}
+
+// runAccessor executes a compiled accessor plan against val. path is the full
+// compiled path, used to report lazy values resolved at the end of the plan.
+func runAccessor(val reflect.Value, steps []accessorStep, path Path) (any, error) {
+	for i := range steps {
+		step := &steps[i]
+		switch step.op {
+		case opDeref:
+			if val.IsNil() {
+				return nil, ErrNilPointer
+			}
+			val = val.Elem()
+		case opField:
+			val = val.Field(step.index)
+		case opIndex:
+			if step.index >= val.Len() {
+				return nil, ErrIndexOutOfBounds
+			}
+			val = val.Index(step.index)
+		case opKey:
+			entry := val.MapIndex(step.key)
+			if !entry.IsValid() {
+				return nil, ErrKeyNotFound
+			}
+			val = entry
+		case opDynamic:
+			return get(val.Interface(), step.rest)
+		}
+	}
+
+	result := val.Interface()
+	if lazy, ok := asLazy(result); ok {
+		return resolveLazy(lazy, path)
+	}
+	return result, nil
+}
@@ -0,0 +1,124 @@
+package jsonpointer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type accessorAddress struct {
+	City string `json:"city"`
+}
+
+type accessorUser struct {
+	Name    string                      `json:"name"`
+	Tags    []string                    `json:"tags"`
+	Scores  [2]int                      `json:"scores"`
+	Address *accessorAddress            `json:"address"`
+	Labels  map[string]string           `json:"labels"`
+	Nested  map[string]*accessorAddress `json:"nested"`
+	Extra   any                         `json:"extra"`
+	Raw     json.RawMessage             `json:"raw"`
+	Lazy    LazyValue                   `json:"lazy"`
+	Count   int
+}
+
+func TestCompileAccessor(t *testing.T) {
+	user := &accessorUser{
+		Name:    "Alice",
+		Tags:    []string{"admin", "dev"},
+		Scores:  [2]int{7, 9},
+		Address: &accessorAddress{City: "Paris"},
+		Labels:  map[string]string{"team": "core"},
+		Nested:  map[string]*accessorAddress{"home": {City: "Lyon"}, "none": nil},
+		Extra:   map[string]any{"items": []any{"x", map[string]any{"id": 1}}},
+		Raw:     json.RawMessage(`{"a":[1,2]}`),
+		Lazy:    lazyFunc(func() (any, error) { return map[string]any{"v": "lazy"}, nil }),
+		Count:   3,
+	}
+
+	pointers := []string{
+		"",
+		"/name",
+		"/Count",
+		"/tags/1",
+		"/tags/2",
+		"/scores/1",
+		"/address",
+		"/address/city",
+		"/labels/team",
+		"/labels/missing",
+		"/nested/home/city",
+		"/nested/none/city",
+		"/extra/items/1/id",
+		"/extra/items/5",
+		"/extra/missing",
+		"/raw/a/1",
+		"/lazy",
+		"/lazy/v",
+	}
+
+	for _, pointer := range pointers {
+		t.Run(pointer, func(t *testing.T) {
+			access, err := CompileAccessor[accessorUser](pointer)
+			require.NoError(t, err)
+
+			want, wantErr := Get(user, Parse(pointer)...)
+			got, gotErr := access(user)
+			assert.Equal(t, want, got)
+			if wantErr != nil {
+				assert.EqualError(t, gotErr, wantErr.Error())
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+
+	t.Run("reads many instances", func(t *testing.T) {
+		access, err := CompileAccessor[accessorUser]("/address/city")
+		require.NoError(t, err)
+
+		for _, city := range []string{"Oslo", "Rome"} {
+			got, err := access(&accessorUser{Address: &accessorAddress{City: city}})
+			require.NoError(t, err)
+			assert.Equal(t, city, got)
+		}
+	})
+
+	t.Run("nil pointers", func(t *testing.T) {
+		access, err := CompileAccessor[accessorUser]("/address/city")
+		require.NoError(t, err)
+
+		_, err = access(&accessorUser{})
+		assert.ErrorIs(t, err, ErrNilPointer)
+		_, err = access(nil)
+		assert.ErrorIs(t, err, ErrNilPointer)
+	})
+
+	t.Run("unresolvable pointers fail to compile", func(t *testing.T) {
+		tests := []struct {
+			pointer string
+			err     error
+		}{
+			{"/missing", ErrFieldNotFound},
+			{"/name/first", ErrNotFound},
+			{"/tags/01", ErrInvalidIndex},
+			{"/tags/-", ErrIndexOutOfBounds},
+			{"/tags/-/x", ErrArrayEndNotFinal},
+			{"/scores/2", ErrIndexOutOfBounds},
+			{"/address/zip", ErrFieldNotFound},
+		}
+		for _, tt := range tests {
+			_, err := CompileAccessor[accessorUser](tt.pointer)
+			assert.ErrorIs(t, err, tt.err, tt.pointer)
+			assert.ErrorContains(t, err, tt.pointer)
+		}
+	})
+
+	t.Run("invalid pointer", func(t *testing.T) {
+		_, err := CompileAccessor[accessorUser]("name")
+		assert.ErrorIs(t, err, ErrPointerInvalid)
+	})
+}
@@ -2,6 +2,7 @@ package benchmarks
 
 import (
 	"encoding/json"
+	"strconv"
 	"testing"
 
 	// Our implementation
@@ -41,6 +42,19 @@ var (
 	// Struct test data
 	structData = generateStructData()
 	mapData    = generateMapData()
+
+	// Raw message test data
+	rawMessageData = generateRawMessageData()
+
+	// Mixed array test data
+	mixedData = map[string]any{
+		"items": []any{
+			"text",
+			42,
+			&BenchProfile{Email: "alice@example.com", Settings: BenchSettings{Theme: "dark"}},
+			map[string]string{"theme": "light"},
+		},
+	}
 )
 
 func generateMediumData() map[string]any {
@@ -61,6 +75,15 @@ func generateMediumData() map[string]any {
 	}
 }
 
+func generateRawMessageData() map[string]json.RawMessage {
+	entry, _ := json.Marshal(smallData)
+	data := make(map[string]json.RawMessage, 1000)
+	for i := 0; i < 1000; i++ {
+		data["entry"+strconv.Itoa(i)] = entry
+	}
+	return data
+}
+
 // Struct definitions for benchmark testing
 type BenchUser struct {
 	Name    string       `json:"name"`
@@ -165,6 +188,44 @@ func BenchmarkOur_Medium_FindUser(b *testing.B) {
 	}
 }
 
+func BenchmarkOur_Medium_FindUserInto(b *testing.B) {
+	var ref ourjp.Reference
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ourjp.FindByPointerInto(mediumData, "/users/50/name", &ref)
+	}
+}
+
+func BenchmarkOur_RawMessageMap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ourjp.GetByPointer(rawMessageData, "/entry500/profile/settings/theme")
+	}
+}
+
+func BenchmarkOur_MixedArray_StructPointer(b *testing.B) {
+	path := ourjp.Parse("/items/2/settings/theme")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ourjp.Get(mixedData, path...)
+	}
+}
+
+func BenchmarkOur_MixedArray_StringMap(b *testing.B) {
+	path := ourjp.Parse("/items/3/theme")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ourjp.Get(mixedData, path...)
+	}
+}
+
+func BenchmarkOur_MixedArray_FindByPointer(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ourjp.FindByPointer(mixedData, "/items/2/settings/theme")
+	}
+}
+
 func BenchmarkOur_NotFound(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_, _ = ourjp.FindByPointer(smallData, "/nonexistent")
@@ -445,3 +506,220 @@ func BenchmarkOur_Struct_FieldName(b *testing.B) {
 		_, _ = ourjp.Get(data, "Name") // Uses field name
 	}
 }
+
+// Registered slice type vs reflection fallback for slices of structs.
+// Pointer elements are used so boxing a copied struct does not dominate the measurement.
+func BenchmarkOur_Struct_RegisteredSlice(b *testing.B) {
+	type reflectedUser BenchUser
+
+	ourjp.RegisterSliceType[*BenchUser]()
+	registered := make([]*BenchUser, 100)
+	reflected := make([]*reflectedUser, 100)
+	for i := range registered {
+		user := generateStructData()
+		registered[i] = &user
+		reflected[i] = (*reflectedUser)(&user)
+	}
+	doc := map[string]any{"registered": registered, "reflected": reflected}
+
+	b.Run("registered", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.Get(doc, "registered", "42")
+		}
+	})
+
+	b.Run("reflection", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.Get(doc, "reflected", "42")
+		}
+	})
+}
+
+// Setting an element of a registered slice type vs reflection fallback.
+func BenchmarkOur_Struct_RegisteredSlice_Set(b *testing.B) {
+	type reflectedUser BenchUser
+
+	ourjp.RegisterSliceType[BenchUser]()
+	registered := make([]BenchUser, 100)
+	reflected := make([]reflectedUser, 100)
+
+	var registeredValue any = generateStructData()
+	var reflectedValue any = reflectedUser(generateStructData())
+
+	b.Run("registered", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = ourjp.Set(registered, registeredValue, "42")
+		}
+	})
+
+	b.Run("reflection", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = ourjp.Set(reflected, reflectedValue, "42")
+		}
+	})
+}
+
+// Many deep pointers sharing the /users/0/profile prefix, resolved directly
+// and through a bound document that memoizes shared ancestors.
+func BenchmarkOur_Resolver_BoundDoc(b *testing.B) {
+	user := generateStructData()
+	doc := map[string]any{"users": []any{&user}}
+	pointers := []string{
+		"/users/0/profile/email",
+		"/users/0/profile/settings",
+		"/users/0/profile/settings/theme",
+		"/users/0/profile/settings/notifications",
+	}
+	r := ourjp.New(ourjp.Options{})
+
+	b.Run("resolver", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, pointer := range pointers {
+				_, _ = r.GetByPointer(doc, pointer)
+			}
+		}
+	})
+
+	b.Run("bound", func(b *testing.B) {
+		bound := r.Bind(doc)
+		for i := 0; i < b.N; i++ {
+			for _, pointer := range pointers {
+				_, _ = bound.Get(pointer)
+			}
+		}
+	})
+}
+
+// Nested decoded shapes that previously required reflection in the object fallback
+func BenchmarkOur_Map_TypedNested(b *testing.B) {
+	groups := map[string][]any{"admins": {"alice", "bob"}}
+	sections := map[string]map[string]any{"server": {"port": 8080}}
+
+	b.Run("map_of_slices", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.Get(groups, "admins", "1")
+		}
+	})
+
+	b.Run("map_of_maps", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.Get(sections, "server", "port")
+		}
+	})
+
+	b.Run("map_of_slices_find", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.Find(groups, "admins", "1")
+		}
+	})
+
+	b.Run("map_of_maps_find", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.Find(sections, "server", "port")
+		}
+	})
+}
+
+// Indexing into text held as byte and rune slices
+func BenchmarkOur_Slice_Text(b *testing.B) {
+	doc := map[string]any{
+		"bytes": []byte("hello, world"),
+		"runes": []rune("héllo, wörld"),
+	}
+
+	b.Run("bytes_get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.Get(doc, "bytes", "7")
+		}
+	})
+
+	b.Run("bytes_find", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.Find(doc, "bytes", "7")
+		}
+	})
+
+	b.Run("runes_get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.Get(doc, "runes", "8")
+		}
+	})
+}
+
+// Escape-free deep pointer, resolved from the string each time and precompiled
+func BenchmarkOur_Compiled_Deep(b *testing.B) {
+	const pointer = "/profile/settings/theme"
+
+	b.Run("by_pointer", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.GetByPointer(smallData, pointer)
+		}
+	})
+
+	b.Run("compiled", func(b *testing.B) {
+		c, err := ourjp.Compile(pointer)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = c.Get(smallData)
+		}
+	})
+}
+
+// Reading the same pointer from many struct values with Get and a compiled accessor
+func BenchmarkOur_Struct_CompiledAccessor(b *testing.B) {
+	users := make([]BenchUser, 10000)
+	for i := range users {
+		users[i] = generateStructData()
+	}
+	path := []string{"profile", "settings", "theme"}
+
+	b.Run("get", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.Get(&users[i%len(users)], path...)
+		}
+	})
+
+	b.Run("accessor", func(b *testing.B) {
+		access, err := ourjp.CompileAccessor[BenchUser]("/profile/settings/theme")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = access(&users[i%len(users)])
+		}
+	})
+}
+
+// Evaluating the same wildcard pattern against many documents
+func BenchmarkOur_Pattern_Users(b *testing.B) {
+	const pattern = "/users/*/name"
+
+	b.Run("get_all", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = ourjp.GetAll(mediumData, pattern)
+		}
+	})
+
+	b.Run("compiled", func(b *testing.B) {
+		p, err := ourjp.CompilePattern(pattern)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = p.Match(mediumData)
+		}
+	})
+}
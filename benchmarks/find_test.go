@@ -353,3 +353,43 @@ func BenchmarkTypeGuards(b *testing.B) {
 		}
 	})
 }
+
+// BenchmarkResolveByPointer compares value-only resolution with FindByPointer.
+func BenchmarkResolveByPointer(b *testing.B) {
+	doc := map[string]any{
+		"users": []any{
+			map[string]any{"name": "Alice"},
+		},
+	}
+	pointer := "/users/0/name"
+
+	b.Run("FindByPointer", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := jsonpointer.FindByPointer(doc, pointer)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("ResolveByPointer", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := jsonpointer.ResolveByPointer(doc, pointer)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("ResolveRefByPointer", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _, _, err := jsonpointer.ResolveRefByPointer(doc, pointer)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -6,13 +6,17 @@ go 1.24.3
 replace github.com/kaptinlin/jsonpointer => ../
 
 require (
-	github.com/bragdond/jsonpointer-go v1.0.0 // indirect
-	github.com/dolmen-go/jsonptr v0.0.0-20240328010033-38530b85cd9c // indirect
-	github.com/go-openapi/jsonpointer v0.21.1 // indirect
+	github.com/bragdond/jsonpointer-go v1.0.0
+	github.com/dolmen-go/jsonptr v0.0.0-20240328010033-38530b85cd9c
+	github.com/go-openapi/jsonpointer v0.21.1
+	github.com/kaptinlin/jsonpointer v0.0.0-00010101000000-000000000000
+	github.com/woodsbury/jsonpointer v0.7.1
+)
+
+require (
 	github.com/go-openapi/swag v0.23.1 // indirect
 	github.com/josharian/intern v1.0.0 // indirect
-	github.com/kaptinlin/jsonpointer v0.0.0-00010101000000-000000000000 // indirect
 	github.com/mailru/easyjson v0.9.0 // indirect
-	github.com/woodsbury/jsonpointer v0.7.1 // indirect
+	golang.org/x/text v0.34.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
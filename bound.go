@@ -0,0 +1,94 @@
+package jsonpointer
+
+import "sync"
+
+// BoundDoc resolves JSON Pointers against a single document using a Resolver.
+// Values of intermediate locations are memoized by pointer prefix, so pointers
+// sharing common ancestors only traverse those ancestors once.
+//
+// Cached values are never invalidated: the document must not be modified while
+// it is bound. A BoundDoc is safe for concurrent use.
+type BoundDoc struct {
+	r     *Resolver
+	doc   any
+	cache sync.Map // pointer prefix -> resolved value
+}
+
+// Bind returns a BoundDoc that resolves pointers against doc.
+func (r *Resolver) Bind(doc any) *BoundDoc {
+	return &BoundDoc{r: r, doc: doc}
+}
+
+// Get retrieves a value from the bound document using JSON Pointer string.
+func (b *BoundDoc) Get(pointer string) (any, error) {
+	ref, err := b.Find(pointer)
+	if err != nil {
+		return nil, err
+	}
+	return ref.Val, nil
+}
+
+// Find locates a reference in the bound document using JSON Pointer string.
+func (b *BoundDoc) Find(pointer string) (*Reference, error) {
+	path := parseJsonPointer(pointer)
+	if b.r.opts.MaxDepth > 0 && len(path) > b.r.opts.MaxDepth {
+		return nil, ErrMaxDepthExceeded
+	}
+	if len(path) == 0 {
+		return &Reference{Val: b.doc, root: b.doc, resolver: b.r}, nil
+	}
+
+	// ends[i] is the length of the pointer prefix addressing path[:i+1]
+	var buf [16]int
+	ends := buf[:0]
+	for i := 1; i < len(pointer); i++ {
+		if pointer[i] == '/' {
+			ends = append(ends, i)
+		}
+	}
+	ends = append(ends, len(pointer))
+
+	// Start from the deepest cached ancestor of the target
+	start := 0
+	current := b.doc
+	for i := len(path) - 1; i > 0; i-- {
+		if cached, ok := b.cache.Load(pointer[:ends[i-1]]); ok {
+			start, current = i, cached
+			break
+		}
+	}
+
+	var obj any
+	for i := start; i < len(path); i++ {
+		if lazy, ok := asSteppable(current); ok {
+			resolved, err := resolveLazy(lazy, path[:i])
+			if err != nil {
+				return nil, err
+			}
+			current = resolved
+		}
+		if i > start {
+			b.cache.Store(pointer[:ends[i-1]], current)
+		}
+
+		obj = current
+		if isArrayEndMidPath(current, path[i], i == len(path)-1) {
+			return nil, ErrArrayEndNotFinal
+		}
+		next, err := b.r.stepFrom(current, path[i], i == 0)
+		if err != nil {
+			return nil, err
+		}
+		current = next
+	}
+
+	if lazy, ok := asLazy(current); ok {
+		resolved, err := resolveLazy(lazy, path)
+		if err != nil {
+			return nil, err
+		}
+		current = resolved
+	}
+
+	return &Reference{Val: current, Obj: obj, Key: path[len(path)-1], path: path, root: b.doc, resolver: b.r}, nil
+}
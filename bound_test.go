@@ -0,0 +1,99 @@
+package jsonpointer
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBoundDoc tests resolving pointers against a bound document.
+func TestBoundDoc(t *testing.T) {
+	doc := map[string]any{
+		"users": []any{
+			map[string]any{
+				"profile": &Profile{User: User{Name: "Alice", Age: 30}, Location: "Paris"},
+			},
+		},
+	}
+	r := New(Options{})
+
+	t.Run("results match the resolver", func(t *testing.T) {
+		b := r.Bind(doc)
+		pointers := []string{
+			"", "/users", "/users/0/profile", "/users/0/profile/user/name",
+			"/users/0/profile/location", "/users/0/profile/user/age",
+			"/users/0/profile/missing", "/users/1/profile", "/users/-",
+		}
+		// Resolve twice so the second pass is served from cached prefixes
+		for range 2 {
+			for _, pointer := range pointers {
+				expected, expectedErr := r.FindByPointer(doc, pointer)
+				ref, err := b.Find(pointer)
+				assert.Equal(t, expectedErr, err, pointer)
+				assert.Equal(t, expected, ref, pointer)
+			}
+		}
+	})
+
+	t.Run("Root documents match the resolver", func(t *testing.T) {
+		root := configRoot{sections: map[string]map[string]any{
+			"server": {"port": 8080, "hosts": []any{"a", "b"}},
+		}}
+		b := r.Bind(root)
+		pointers := []string{"", "/server", "/server/port", "/server/hosts/1", "/missing", "/sections"}
+		for range 2 {
+			for _, pointer := range pointers {
+				expected, expectedErr := r.FindByPointer(root, pointer)
+				ref, err := b.Find(pointer)
+				assert.Equal(t, expectedErr, err, pointer)
+				assert.Equal(t, expected, ref, pointer)
+			}
+		}
+	})
+
+	t.Run("shared prefixes are resolved once", func(t *testing.T) {
+		calls := 0
+		lazyDoc := map[string]any{
+			"remote": lazyFunc(func() (any, error) {
+				calls++
+				return map[string]any{"a": 1, "b": 2}, nil
+			}),
+		}
+		b := r.Bind(lazyDoc)
+
+		val, err := b.Get("/remote/a")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+
+		val, err = b.Get("/remote/b")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, val)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("respects resolver options", func(t *testing.T) {
+		b := New(Options{CaseInsensitive: true, MaxDepth: 4}).Bind(doc)
+		val, err := b.Get("/Users/0/Profile/Location")
+		assert.NoError(t, err)
+		assert.Equal(t, "Paris", val)
+
+		_, err = b.Get("/users/0/profile/user/name")
+		assert.Equal(t, ErrMaxDepthExceeded, err)
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		b := r.Bind(doc)
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				val, err := b.Get("/users/0/profile/user/name")
+				assert.NoError(t, err)
+				assert.Equal(t, "Alice", val)
+			}()
+		}
+		wg.Wait()
+	})
+}
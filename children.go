@@ -0,0 +1,160 @@
+package jsonpointer
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// NumChildren returns the number of direct children of the value at pointer:
+// the length of a map, slice or array, or the number of visible fields of a struct.
+// Scalars and nil values have zero children.
+func NumChildren(doc any, pointer string) (int, error) {
+	val, err := GetByPointer(doc, pointer)
+	if err != nil {
+		return 0, err
+	}
+	return numChildren(val), nil
+}
+
+// HasChildren reports whether the value at pointer has at least one direct child.
+func HasChildren(doc any, pointer string) (bool, error) {
+	n, err := NumChildren(doc, pointer)
+	return n > 0, err
+}
+
+// numChildren counts the children forEachChild would visit without iterating them.
+func numChildren(val any) int {
+	switch v := val.(type) {
+	case nil:
+		return 0
+	case json.RawMessage:
+		decoded, err := rawMessage(v).Resolve()
+		if err != nil {
+			return 0
+		}
+		return numChildren(decoded)
+	case map[string]any:
+		return len(v)
+	case []any:
+		return len(v)
+	}
+
+	objVal := reflect.ValueOf(val)
+	for objVal.Kind() == reflect.Ptr {
+		if objVal.IsNil() {
+			return 0
+		}
+		objVal = objVal.Elem()
+	}
+
+	kind := objVal.Kind()
+	if kind == reflect.Slice || kind == reflect.Array {
+		return objVal.Len()
+	}
+	if kind == reflect.Map && objVal.Type().Key().Kind() == reflect.String {
+		return objVal.Len()
+	}
+	if kind == reflect.Struct {
+		return len(getStructFields(objVal.Type()))
+	}
+	return 0
+}
+
+// forEachChild calls fn for each direct child of val in canonical order:
+// map entries by sorted key, array elements by index and struct fields in declaration order.
+// Scalars and nil values have no children. Iteration stops as soon as fn returns false,
+// in which case forEachChild returns false as well.
+func forEachChild(val any, fn func(key string, child any) bool) bool {
+	switch v := val.(type) {
+	case nil:
+		return true
+
+	case json.RawMessage:
+		// Raw JSON is decoded to enumerate its children; invalid JSON has none
+		decoded, err := rawMessage(v).Resolve()
+		if err != nil {
+			return true
+		}
+		return forEachChild(decoded, fn)
+
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if !fn(k, v[k]) {
+				return false
+			}
+		}
+		return true
+
+	case []any:
+		for i, child := range v {
+			if !fn(strconv.Itoa(i), child) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Reflection fallback for other container types
+	objVal := reflect.ValueOf(val)
+	for objVal.Kind() == reflect.Ptr {
+		if objVal.IsNil() {
+			return true
+		}
+		objVal = objVal.Elem()
+	}
+
+	switch objVal.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < objVal.Len(); i++ {
+			if !fn(strconv.Itoa(i), objVal.Index(i).Interface()) {
+				return false
+			}
+		}
+
+	case reflect.Map:
+		if objVal.Type().Key().Kind() != reflect.String {
+			return true
+		}
+		keys := objVal.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, k := range keys {
+			if !fn(k.String(), objVal.MapIndex(k).Interface()) {
+				return false
+			}
+		}
+
+	case reflect.Struct:
+		for _, name := range structFieldNames(objVal.Type()) {
+			field := objVal
+			structField(name, &field)
+			if !fn(name, field.Interface()) {
+				return false
+			}
+		}
+
+	case reflect.Invalid, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.Chan, reflect.Func, reflect.Interface, reflect.Ptr, reflect.String, reflect.UnsafePointer:
+		// Scalars and unsupported kinds have no children
+	}
+	return true
+}
+
+// structFieldNames returns the visible field names of struct type in declaration order.
+func structFieldNames(t reflect.Type) []string {
+	fields := getStructFields(t)
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return fields[names[i]] < fields[names[j]] })
+	return names
+}
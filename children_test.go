@@ -0,0 +1,75 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNumChildren tests counting direct children of tree nodes.
+func TestNumChildren(t *testing.T) {
+	doc := map[string]any{
+		"user":    User{Name: "Alice"},
+		"userPtr": &User{Name: "Bob"},
+		"nilUser": (*User)(nil),
+		"object":  map[string]any{"a": 1, "b": 2},
+		"empty":   map[string]any{},
+		"labels":  map[string]string{"env": "prod"},
+		"items":   []any{1, 2, 3},
+		"tags":    []string{},
+		"pair":    [2]int{1, 2},
+		"name":    "Alice",
+		"count":   42,
+		"null":    nil,
+	}
+
+	tests := []struct {
+		name     string
+		pointer  string
+		expected int
+	}{
+		{"root map", "", 12},
+		{"struct counts visible fields", "/user", 3},
+		{"struct pointer", "/userPtr", 3},
+		{"nil struct pointer", "/nilUser", 0},
+		{"map", "/object", 2},
+		{"empty map", "/empty", 0},
+		{"typed map", "/labels", 1},
+		{"slice", "/items", 3},
+		{"empty typed slice", "/tags", 0},
+		{"array", "/pair", 2},
+		{"string scalar", "/name", 0},
+		{"number scalar", "/count", 0},
+		{"null", "/null", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := NumChildren(doc, tt.pointer)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, n)
+
+			has, err := HasChildren(doc, tt.pointer)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected > 0, has)
+
+			// Counts agree with the children visited during traversal
+			val, _ := GetByPointer(doc, tt.pointer)
+			visited := 0
+			forEachChild(val, func(string, any) bool {
+				visited++
+				return true
+			})
+			assert.Equal(t, visited, n)
+		})
+	}
+
+	t.Run("missing node", func(t *testing.T) {
+		_, err := NumChildren(doc, "/missing")
+		assert.Equal(t, ErrKeyNotFound, err)
+
+		has, err := HasChildren(doc, "/missing")
+		assert.Equal(t, ErrKeyNotFound, err)
+		assert.False(t, has)
+	})
+}
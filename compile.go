@@ -0,0 +1,54 @@
+package jsonpointer
+
+import "fmt"
+
+// CompiledPointer is a validated JSON Pointer parsed once for repeated use.
+// It is immutable and safe for concurrent use.
+type CompiledPointer struct {
+	pointer string
+	path    Path
+}
+
+// Compile validates and parses a JSON Pointer string for repeated use.
+// It returns the same errors as Validate for invalid pointers.
+func Compile(pointer string) (*CompiledPointer, error) {
+	if err := validatePointerString(pointer); err != nil {
+		return nil, err
+	}
+	return &CompiledPointer{pointer: pointer, path: parseJsonPointer(pointer)}, nil
+}
+
+// CompileAll compiles each pointer in order. If any pointer is invalid, it
+// returns an error naming the first offending pointer and wrapping its
+// validation error.
+func CompileAll(pointers []string) ([]*CompiledPointer, error) {
+	compiled := make([]*CompiledPointer, len(pointers))
+	for i, pointer := range pointers {
+		c, err := Compile(pointer)
+		if err != nil {
+			return nil, fmt.Errorf("compile pointer %q: %w", pointer, err)
+		}
+		compiled[i] = c
+	}
+	return compiled, nil
+}
+
+// String returns the JSON Pointer string the pointer was compiled from.
+func (c *CompiledPointer) String() string {
+	return c.pointer
+}
+
+// Path returns a copy of the parsed path.
+func (c *CompiledPointer) Path() Path {
+	return c.path.Clone()
+}
+
+// Get retrieves the value at the pointer in document.
+func (c *CompiledPointer) Get(doc any) (any, error) {
+	return Get(doc, c.path...)
+}
+
+// Find locates a reference to the pointer in document.
+func (c *CompiledPointer) Find(doc any) (*Reference, error) {
+	return Find(doc, c.path...)
+}
@@ -0,0 +1,63 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCompile tests compiling and reusing a single pointer.
+func TestCompile(t *testing.T) {
+	doc := map[string]any{"users": []any{map[string]any{"a/b": "x"}}}
+
+	c, err := Compile("/users/0/a~1b")
+	assert.NoError(t, err)
+	assert.Equal(t, "/users/0/a~1b", c.String())
+	assert.Equal(t, Path{"users", "0", "a/b"}, c.Path())
+
+	val, err := c.Get(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, "x", val)
+
+	ref, err := c.Find(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, "a/b", ref.Key)
+
+	// The returned path is a copy
+	c.Path()[0] = "changed"
+	assert.Equal(t, Path{"users", "0", "a/b"}, c.Path())
+
+	root, err := Compile("")
+	assert.NoError(t, err)
+	val, err = root.Get(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, doc, val)
+
+	_, err = Compile("users")
+	assert.Equal(t, ErrPointerInvalid, err)
+	_, err = Compile("/a~2")
+	assert.Equal(t, ErrPointerInvalid, err)
+}
+
+// TestCompileAll tests batch compilation.
+func TestCompileAll(t *testing.T) {
+	t.Run("all valid", func(t *testing.T) {
+		compiled, err := CompileAll([]string{"/a", "/b/0", ""})
+		assert.NoError(t, err)
+		assert.Len(t, compiled, 3)
+		assert.Equal(t, "/b/0", compiled[1].String())
+	})
+
+	t.Run("first invalid pointer is reported", func(t *testing.T) {
+		compiled, err := CompileAll([]string{"/a", "b", "/c~", "/d"})
+		assert.Nil(t, compiled)
+		assert.ErrorIs(t, err, ErrPointerInvalid)
+		assert.Contains(t, err.Error(), `"b"`)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		compiled, err := CompileAll(nil)
+		assert.NoError(t, err)
+		assert.Empty(t, compiled)
+	})
+}
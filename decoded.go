@@ -0,0 +1,103 @@
+package jsonpointer
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// FromDecoded returns a copy of v in the shape produced by encoding/json, so the
+// fast paths for map[string]any and []any apply. It is meant to be run once on
+// values decoded by other codecs such as gob, msgpack or YAML: maps of any key
+// type (for example map[interface{}]interface{} or map[int]interface{}) become
+// map[string]any, with keys formatted in decimal for integers and with fmt.Sprint
+// otherwise, and slices and arrays other than byte slices become []any. A
+// json.RawMessage is decoded and converted in turn, or kept as-is if it is not
+// valid JSON. Other byte slices, including named types based on []byte, structs,
+// pointers and scalars are kept as-is. Nested values are converted recursively;
+// v itself is not modified.
+func FromDecoded(v any) any {
+	switch val := v.(type) {
+	case nil, string, bool, float64, int, int64, []byte:
+		return v
+	case json.RawMessage:
+		decoded, err := rawMessage(val).Resolve()
+		if err != nil {
+			return v
+		}
+		return FromDecoded(decoded)
+	case map[string]any:
+		result := make(map[string]any, len(val))
+		for k, child := range val {
+			result[k] = FromDecoded(child)
+		}
+		return result
+	case map[any]any:
+		result := make(map[string]any, len(val))
+		for k, child := range val {
+			result[decodedKey(reflect.ValueOf(k))] = FromDecoded(child)
+		}
+		return result
+	case []any:
+		result := make([]any, len(val))
+		for i, child := range val {
+			result[i] = FromDecoded(child)
+		}
+		return result
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.IsNil() {
+			return v
+		}
+		result := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			result[decodedKey(iter.Key())] = FromDecoded(iter.Value().Interface())
+		}
+		return result
+
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && (rv.IsNil() || rv.Type().Elem().Kind() == reflect.Uint8) {
+			return v
+		}
+		result := make([]any, rv.Len())
+		for i := range result {
+			result[i] = FromDecoded(rv.Index(i).Interface())
+		}
+		return result
+
+	case reflect.Invalid, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.Chan, reflect.Func, reflect.Interface, reflect.Ptr, reflect.String, reflect.Struct, reflect.UnsafePointer:
+		// Scalars, structs and pointers are kept as-is
+		return v
+	}
+	return v
+}
+
+// decodedKey formats a map key of any type as an object key.
+func decodedKey(key reflect.Value) string {
+	if key.Kind() == reflect.Interface && !key.IsNil() {
+		key = key.Elem()
+	}
+	if !key.IsValid() {
+		return fmt.Sprint(nil)
+	}
+
+	kind := key.Kind()
+	if kind == reflect.String {
+		return key.String()
+	}
+	if kind >= reflect.Int && kind <= reflect.Int64 {
+		return strconv.FormatInt(key.Int(), 10)
+	}
+	if kind >= reflect.Uint && kind <= reflect.Uintptr {
+		return strconv.FormatUint(key.Uint(), 10)
+	}
+	return fmt.Sprint(key.Interface())
+}
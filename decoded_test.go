@@ -0,0 +1,75 @@
+package jsonpointer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFromDecoded tests normalizing values decoded by non-JSON codecs.
+func TestFromDecoded(t *testing.T) {
+	decoded := map[any]any{
+		"users": []map[any]any{
+			{"name": "Alice", "roles": []string{"admin"}},
+		},
+		1:      map[int]any{0: "zero", 10: "ten"},
+		true:   "yes",
+		"blob": []byte{1, 2},
+		"nil":  nil,
+	}
+
+	normalized := FromDecoded(decoded)
+	assert.Equal(t, map[string]any{
+		"users": []any{
+			map[string]any{"name": "Alice", "roles": []any{"admin"}},
+		},
+		"1":    map[string]any{"0": "zero", "10": "ten"},
+		"true": "yes",
+		"blob": []byte{1, 2},
+		"nil":  nil,
+	}, normalized)
+
+	pointers := map[string]any{
+		"/users/0/name":    "Alice",
+		"/users/0/roles/0": "admin",
+		"/1/10":            "ten",
+		"/true":            "yes",
+	}
+	for pointer, expected := range pointers {
+		val, err := GetByPointer(normalized, pointer)
+		assert.NoError(t, err, pointer)
+		assert.Equal(t, expected, val, pointer)
+	}
+
+	// The decoded value is not modified
+	assert.IsType(t, map[int]any{}, decoded[1])
+
+	t.Run("leaves and structs are kept", func(t *testing.T) {
+		user := &User{Name: "Bob"}
+		assert.Equal(t, "x", FromDecoded("x"))
+		assert.Equal(t, 1.5, FromDecoded(1.5))
+		assert.Same(t, user, FromDecoded(user))
+		assert.Equal(t, User{Name: "Bob"}, FromDecoded(User{Name: "Bob"}))
+		assert.Nil(t, FromDecoded(nil))
+		assert.Equal(t, []any{1, 2}, FromDecoded([2]int{1, 2}))
+	})
+
+	t.Run("byte slices", func(t *testing.T) {
+		type checksum []byte
+		normalized := FromDecoded(map[any]any{
+			"sum":     checksum{1, 2},
+			"raw":     json.RawMessage(`{"a":[1,{"b":"c"}]}`),
+			"invalid": json.RawMessage(`{`),
+		})
+		assert.Equal(t, map[string]any{
+			"sum":     checksum{1, 2},
+			"raw":     map[string]any{"a": []any{1.0, map[string]any{"b": "c"}}},
+			"invalid": json.RawMessage(`{`),
+		}, normalized)
+
+		val, err := GetByPointer(normalized, "/raw/a/1/b")
+		assert.NoError(t, err)
+		assert.Equal(t, "c", val)
+	})
+}
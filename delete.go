@@ -0,0 +1,160 @@
+package jsonpointer
+
+import "reflect"
+
+// Delete removes the value at path from document, modifying it in place.
+//
+// Map entries are deleted and array elements are removed, shifting later elements
+// left like slices.Delete; the shortened slice is stored back into its parent, so
+// other slices sharing the backing array observe the shift. Struct fields cannot be
+// removed and are reset to their zero value instead, and elements of fixed-size
+// arrays cannot be removed at all and return ErrNotAddressable.
+//
+// The root must be updatable in place as for Set, so removing an element of a
+// top-level slice requires a pointer to it. The root itself cannot be deleted and
+// an empty path returns ErrNoParent. A missing location returns the lookup error,
+// such as ErrKeyNotFound, without modifying doc.
+func Delete(doc any, path ...string) error {
+	return remove(nil, doc, Path(path))
+}
+
+// DeleteByPointer removes the value at the location of a JSON Pointer string, like Delete.
+func DeleteByPointer(doc any, pointer string) error {
+	return Delete(doc, parseJsonPointer(pointer)...)
+}
+
+// remove implements Delete, matching map keys and struct fields with r; a nil r
+// uses the package defaults.
+func remove(r *Resolver, doc any, path Path) error {
+	if len(path) == 0 {
+		return ErrNoParent
+	}
+	rootVal := reflect.ValueOf(doc)
+	if err := checkRootInPlace(rootVal, rootVal.Kind() == reflect.Slice && len(path) == 1); err != nil {
+		return err
+	}
+	if doc == nil {
+		return ErrNotFound
+	}
+
+	_, err := removePath(r, rootVal, path)
+	return err
+}
+
+// removePath removes the value at path within current and returns the updated
+// current value, following setPath for everything before the final component.
+func removePath(r *Resolver, current reflect.Value, path Path) (reflect.Value, error) {
+	// Unwrap interface values to reach the concrete container
+	for current.Kind() == reflect.Interface {
+		if current.IsNil() {
+			return reflect.Value{}, ErrNotFound
+		}
+		current = current.Elem()
+	}
+
+	key := path[0]
+	last := len(path) == 1
+	switch current.Kind() {
+	case reflect.Ptr:
+		if current.IsNil() {
+			return reflect.Value{}, ErrNilPointer
+		}
+		elem := current.Elem()
+		newElem, err := removePath(r, elem, path)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if err := assignTo(elem, newElem); err != nil {
+			return reflect.Value{}, err
+		}
+		return current, nil
+
+	case reflect.Map:
+		if current.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, ErrNotFound
+		}
+		mapKey := reflect.ValueOf(r.mapKey(current, key)).Convert(current.Type().Key())
+		child := current.MapIndex(mapKey)
+		if !child.IsValid() {
+			return reflect.Value{}, ErrKeyNotFound
+		}
+		if last {
+			current.SetMapIndex(mapKey, reflect.Value{})
+			return current, nil
+		}
+		newChild, err := removePath(r, child, path[1:])
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		newChild, err = assignableValue(newChild, current.Type().Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		current.SetMapIndex(mapKey, newChild)
+		return current, nil
+
+	case reflect.Slice, reflect.Array:
+		index, err := arrayIndex(key, current.Len())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if last {
+			if current.Kind() == reflect.Array {
+				return reflect.Value{}, ErrNotAddressable
+			}
+			// Slice types registered with RegisterSliceType remove elements without reflection
+			if accessor, ok := registeredSlice(current.Type()); ok {
+				return accessor.remove(current, index), nil
+			}
+			return removeElem(current, index), nil
+		}
+		current = addressable(current)
+		elem := current.Index(index)
+		newElem, err := removePath(r, elem, path[1:])
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if err := assignTo(elem, newElem); err != nil {
+			return reflect.Value{}, err
+		}
+		return current, nil
+
+	case reflect.Struct:
+		index, ok := r.fieldIndex(current.Type(), key)
+		if !ok {
+			return reflect.Value{}, ErrFieldNotFound
+		}
+		current = addressable(current)
+		field := current.Field(index)
+		if last {
+			field.SetZero()
+			return current, nil
+		}
+		newField, err := removePath(r, field, path[1:])
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if err := assignTo(field, newField); err != nil {
+			return reflect.Value{}, err
+		}
+		return current, nil
+
+	case reflect.Invalid, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.Chan, reflect.Func, reflect.Interface, reflect.String, reflect.UnsafePointer:
+		// Handle all other reflect.Kind types not supported for JSON Pointer traversal
+		return reflect.Value{}, ErrNotFound
+	}
+	return reflect.Value{}, ErrNotFound
+}
+
+// removeElem removes the element at index from the slice current in place and
+// returns the shortened slice. The vacated last element is zeroed so it does
+// not keep its value alive.
+func removeElem(current reflect.Value, index int) reflect.Value {
+	length := current.Len()
+	reflect.Copy(current.Slice(index, length), current.Slice(index+1, length))
+	current.Index(length - 1).SetZero()
+	return current.Slice(0, length-1)
+}
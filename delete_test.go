@@ -0,0 +1,92 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDelete tests removing values from documents.
+func TestDelete(t *testing.T) {
+	t.Run("deletes map key", func(t *testing.T) {
+		doc := map[string]any{"a": map[string]any{"b": 1, "c": 2}}
+		assert.NoError(t, Delete(doc, "a", "b"))
+		assert.Equal(t, map[string]any{"a": map[string]any{"c": 2}}, doc)
+	})
+
+	t.Run("removes slice element and stores it back", func(t *testing.T) {
+		doc := map[string]any{"arr": []any{1, 2, 3}}
+		assert.NoError(t, DeleteByPointer(doc, "/arr/0"))
+		assert.Equal(t, []any{2, 3}, doc["arr"])
+	})
+
+	t.Run("removes typed slice element through pointer", func(t *testing.T) {
+		doc := &struct {
+			Tags []string `json:"tags"`
+		}{Tags: []string{"a", "b", "c"}}
+		assert.NoError(t, DeleteByPointer(doc, "/tags/1"))
+		assert.Equal(t, []string{"a", "c"}, doc.Tags)
+	})
+
+	t.Run("top-level slice requires pointer", func(t *testing.T) {
+		arr := []any{1, 2}
+		assert.Equal(t, ErrNotAddressable, Delete(arr, "0"))
+		assert.NoError(t, Delete(&arr, "0"))
+		assert.Equal(t, []any{2}, arr)
+	})
+
+	t.Run("zeroes struct field", func(t *testing.T) {
+		user := &User{Name: "Alice", Age: 30}
+		assert.NoError(t, DeleteByPointer(user, "/name"))
+		assert.Equal(t, User{Age: 30}, *user)
+	})
+
+	t.Run("struct root must be a pointer", func(t *testing.T) {
+		assert.Equal(t, ErrNotAddressable, Delete(User{}, "name"))
+	})
+
+	t.Run("fixed-size array elements cannot be removed", func(t *testing.T) {
+		doc := &struct {
+			Point [2]int `json:"point"`
+		}{}
+		assert.Equal(t, ErrNotAddressable, DeleteByPointer(doc, "/point/0"))
+	})
+
+	t.Run("missing locations", func(t *testing.T) {
+		doc := map[string]any{"arr": []any{1}, "user": &User{}}
+		assert.Equal(t, ErrKeyNotFound, Delete(doc, "missing"))
+		assert.Equal(t, ErrIndexOutOfBounds, Delete(doc, "arr", "1"))
+		assert.Equal(t, ErrIndexOutOfBounds, Delete(doc, "arr", "-"))
+		assert.Equal(t, ErrFieldNotFound, Delete(doc, "user", "missing"))
+		assert.Equal(t, ErrNoParent, Delete(doc))
+		assert.Equal(t, map[string]any{"arr": []any{1}, "user": &User{}}, doc)
+	})
+}
+
+// TestResolverDelete tests that Resolver.Delete honors the configured options.
+func TestResolverDelete(t *testing.T) {
+	t.Run("custom tag", func(t *testing.T) {
+		cfg := &yamlConfig{Host: "localhost", Port: 8080}
+		r := New(Options{TagName: "yaml"})
+
+		assert.NoError(t, r.DeleteByPointer(cfg, "/host"))
+		assert.Equal(t, yamlConfig{Port: 8080}, *cfg)
+		assert.Equal(t, ErrFieldNotFound, r.Delete(cfg, "hostname"))
+	})
+
+	t.Run("case-insensitive removes existing entries", func(t *testing.T) {
+		doc := map[string]any{"Users": map[string]any{"Admin": "root", "guest": "x"}}
+		r := New(Options{CaseInsensitive: true})
+
+		assert.NoError(t, r.Delete(doc, "users", "ADMIN"))
+		assert.Equal(t, map[string]any{"guest": "x"}, doc["Users"])
+		assert.Equal(t, ErrKeyNotFound, Delete(doc, "users"))
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		doc := map[string]any{"a": map[string]any{"b": 1}}
+		err := New(Options{MaxDepth: 1}).Delete(doc, "a", "b")
+		assert.Equal(t, ErrMaxDepthExceeded, err)
+		assert.Equal(t, 1, doc["a"].(map[string]any)["b"])
+	})
+}
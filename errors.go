@@ -55,3 +55,51 @@ var ErrFieldNotFound = errors.New("struct field not found")
 
 // ErrKeyNotFound is returned when trying to access a non-existent map key.
 var ErrKeyNotFound = errors.New("map key not found")
+
+// ErrNotText is returned when a value is expected to be a string or byte slice but is not.
+var ErrNotText = errors.New("value is not a string or byte slice")
+
+// ErrMaxDepthExceeded is returned when a path is deeper than the configured maximum depth.
+var ErrMaxDepthExceeded = errors.New("maximum path depth exceeded")
+
+// ErrNotAddressable is returned when a value cannot be modified in place.
+var ErrNotAddressable = errors.New("value is not addressable")
+
+// ErrArrayEndNotFinal is returned when the "-" array end marker is used on an array
+// before the final path component, where it can never resolve.
+var ErrArrayEndNotFinal = errors.New("array end marker must be the final path component")
+
+// ErrTypeMismatch is returned when a value cannot be stored at a location of a different type.
+var ErrTypeMismatch = errors.New("value type mismatch")
+
+// ErrMultipleMatches is returned when a wildcard pattern expected to match a single
+// node matches more than one.
+var ErrMultipleMatches = errors.New("pattern matches more than one node")
+
+// ErrInvalidExpression is returned when an accessor expression passed to ParseExpr is malformed.
+var ErrInvalidExpression = errors.New("invalid accessor expression")
+
+// ErrDuplicateFieldName is returned when several struct fields are tagged with the same name.
+var ErrDuplicateFieldName = errors.New("duplicate struct field name")
+
+// ErrNotStruct is returned when a struct type is expected but another type is given.
+var ErrNotStruct = errors.New("not a struct type")
+
+// ErrInvalidSelector is returned when a field selector passed to PointerTo does not
+// return the address of a field of its argument.
+var ErrInvalidSelector = errors.New("invalid field selector")
+
+// ErrExternalRef is returned when a "$ref" passed to ResolveRef points outside the document.
+var ErrExternalRef = errors.New("external reference")
+
+// ErrTooManyMatches is returned when a wildcard pattern matches more nodes than a
+// Resolver's MaxMatches allows.
+var ErrTooManyMatches = errors.New("pattern matches too many nodes")
+
+// ErrLazyResolutionLimit is returned when a LazyValue keeps resolving to further
+// LazyValues, such as one that resolves to itself, without producing a value.
+var ErrLazyResolutionLimit = errors.New("lazy value resolution limit exceeded")
+
+// ErrNoLocation is returned when navigating from a Reference that does not record
+// where it was found, such as one constructed by the caller.
+var ErrNoLocation = errors.New("reference has no recorded location")
@@ -0,0 +1,91 @@
+package jsonpointer
+
+import "strings"
+
+// ParseExpr parses a JavaScript-style accessor expression into a Path.
+// Expressions combine dot segments ("users.name"), index brackets ("users[0]")
+// and quoted brackets for keys containing special characters ("[\"a/b\"].c" or
+// "['a.b']"). Inside quotes, a backslash escapes the next quote or backslash.
+// The first segment may omit its leading dot; an empty expression yields the
+// root (empty) Path. Malformed expressions return ErrInvalidExpression.
+//
+// Examples:
+//
+//	ParseExpr("users[0].profile.email") // Path{"users", "0", "profile", "email"}
+//	ParseExpr(`["a/b"].c`)              // Path{"a/b", "c"}
+func ParseExpr(expr string) (Path, error) {
+	path := Path{}
+	i := 0
+	for i < len(expr) {
+		switch {
+		case expr[i] == '[':
+			key, next, err := parseExprBracket(expr, i+1)
+			if err != nil {
+				return nil, err
+			}
+			path = append(path, key)
+			i = next
+
+		case i == 0 || expr[i] == '.':
+			if i > 0 {
+				i++
+			}
+			end := i
+			for end < len(expr) && expr[end] != '.' && expr[end] != '[' {
+				end++
+			}
+			if end == i {
+				return nil, ErrInvalidExpression
+			}
+			path = append(path, expr[i:end])
+			i = end
+
+		default:
+			// A closing bracket must be followed by '.', '[' or the end
+			return nil, ErrInvalidExpression
+		}
+	}
+	return path, nil
+}
+
+// parseExprBracket parses the contents of a bracket segment starting at start,
+// just after the opening '['. It returns the key and the position after the
+// closing ']'.
+func parseExprBracket(expr string, start int) (string, int, error) {
+	if start >= len(expr) {
+		return "", 0, ErrInvalidExpression
+	}
+
+	quote := expr[start]
+	if quote != '"' && quote != '\'' {
+		// Unquoted brackets hold an array index
+		end := start
+		for end < len(expr) && expr[end] >= '0' && expr[end] <= '9' {
+			end++
+		}
+		if end == start || end >= len(expr) || expr[end] != ']' {
+			return "", 0, ErrInvalidExpression
+		}
+		return expr[start:end], end + 1, nil
+	}
+
+	var key strings.Builder
+	for i := start + 1; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			if i+1 >= len(expr) || (expr[i+1] != quote && expr[i+1] != '\\') {
+				return "", 0, ErrInvalidExpression
+			}
+			i++ // Skip the escaped character
+			key.WriteByte(expr[i])
+		case quote:
+			if i+1 >= len(expr) || expr[i+1] != ']' {
+				return "", 0, ErrInvalidExpression
+			}
+			return key.String(), i + 2, nil
+		default:
+			key.WriteByte(expr[i])
+		}
+	}
+	return "", 0, ErrInvalidExpression
+}
@@ -0,0 +1,68 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseExpr tests parsing of accessor expressions.
+func TestParseExpr(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected Path
+	}{
+		{"", Path{}},
+		{"users", Path{"users"}},
+		{"users[0].profile.email", Path{"users", "0", "profile", "email"}},
+		{"[0][1]", Path{"0", "1"}},
+		{`["a/b"].c`, Path{"a/b", "c"}},
+		{`users["first.name"]`, Path{"users", "first.name"}},
+		{`['it\'s']`, Path{"it's"}},
+		{`["say \"hi\""]`, Path{`say "hi"`}},
+		{`["back\\slash"]`, Path{`back\slash`}},
+		{`["~0"]`, Path{"~0"}},
+		{`[""]`, Path{""}},
+		{"a.-", Path{"a", "-"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			path, err := ParseExpr(tt.expr)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, path)
+		})
+	}
+
+	invalid := []string{
+		".users",
+		"users.",
+		"users..name",
+		"users[",
+		"users[]",
+		"users[-1]",
+		"users[a]",
+		"users[0]name",
+		`users["name]`,
+		`users["name"`,
+		`users["na\me"]`,
+		`users['name"]`,
+	}
+	for _, expr := range invalid {
+		t.Run("invalid "+expr, func(t *testing.T) {
+			_, err := ParseExpr(expr)
+			assert.Equal(t, ErrInvalidExpression, err)
+		})
+	}
+
+	t.Run("usable with Get", func(t *testing.T) {
+		doc := map[string]any{
+			"users": []any{map[string]any{"a/b": map[string]any{"c": "found"}}},
+		}
+		path, err := ParseExpr(`users[0]["a/b"].c`)
+		assert.NoError(t, err)
+		val, err := Get(doc, path...)
+		assert.NoError(t, err)
+		assert.Equal(t, "found", val)
+	})
+}
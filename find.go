@@ -1,6 +1,7 @@
 package jsonpointer
 
 import (
+	"encoding/json"
 	"reflect"
 	"strconv"
 )
@@ -10,7 +11,7 @@ import (
 func find(val any, path Path) (*Reference, error) {
 	pathLength := len(path)
 	if pathLength == 0 {
-		return &Reference{Val: val}, nil
+		return &Reference{Val: val, root: val}, nil
 	}
 
 	var obj any
@@ -24,6 +25,9 @@ func find(val any, path Path) (*Reference, error) {
 		if current == nil {
 			return nil, ErrNotFound
 		}
+		if isArrayEndMidPath(current, key, i == pathLength-1) {
+			return nil, ErrArrayEndNotFinal
+		}
 
 		// Inline ultra-fast path - avoid function call overhead
 		switch v := current.(type) {
@@ -153,6 +157,46 @@ func find(val any, path Path) (*Reference, error) {
 				}
 			}
 
+		case []byte:
+			if key == "-" {
+				// "-" refers to nonexistent element (JSON Pointer spec)
+				return nil, ErrIndexOutOfBounds
+			} else {
+				index := fastAtoi(key)
+				if index < 0 || strconv.Itoa(index) != key {
+					return nil, ErrInvalidIndex
+				}
+				switch {
+				case index < len(v):
+					current = v[index]
+				case index == len(v):
+					// Array end position is nonexistent element (JSON Pointer spec)
+					return nil, ErrIndexOutOfBounds
+				default:
+					return nil, ErrIndexOutOfBounds
+				}
+			}
+
+		case []rune:
+			if key == "-" {
+				// "-" refers to nonexistent element (JSON Pointer spec)
+				return nil, ErrIndexOutOfBounds
+			} else {
+				index := fastAtoi(key)
+				if index < 0 || strconv.Itoa(index) != key {
+					return nil, ErrInvalidIndex
+				}
+				switch {
+				case index < len(v):
+					current = v[index]
+				case index == len(v):
+					// Array end position is nonexistent element (JSON Pointer spec)
+					return nil, ErrIndexOutOfBounds
+				default:
+					return nil, ErrIndexOutOfBounds
+				}
+			}
+
 		// Fast path for other common map types
 		case map[string]string:
 			if result, exists := v[key]; exists {
@@ -175,12 +219,65 @@ func find(val any, path Path) (*Reference, error) {
 				return nil, ErrKeyNotFound
 			}
 
+		case map[string][]any:
+			if result, exists := v[key]; exists {
+				current = result
+			} else {
+				return nil, ErrKeyNotFound
+			}
+
+		case map[string]map[string]any:
+			if result, exists := v[key]; exists {
+				current = result
+			} else {
+				return nil, ErrKeyNotFound
+			}
+
+		case map[string]json.RawMessage:
+			// Only the selected entry is decoded, when traversal continues into it
+			if result, exists := v[key]; exists {
+				current = result
+			} else {
+				return nil, ErrKeyNotFound
+			}
+
 		default:
+			// Lazy values are resolved before traversing into them
+			if lazy, ok := asSteppable(current); ok {
+				resolved, err := resolveLazy(lazy, path[:i])
+				if err != nil {
+					return nil, err
+				}
+				if resolved == nil {
+					return nil, ErrNotFound
+				}
+				current, obj = resolved, resolved
+			}
+
+			// Types implementing FieldAccessor or Sequence provide their own field lookup
+			if accessor, ok := asFieldAccessor(current); ok {
+				result, exists := accessor.Field(key)
+				if !exists {
+					return nil, ErrFieldNotFound
+				}
+				current = result
+				continue
+			}
+
+			// Slice types registered with RegisterSliceType avoid reflection
+			if result, handled, err := registeredSliceAccess(current, internalToken{key: key, index: fastAtoi(key)}); handled {
+				if err != nil {
+					return nil, err
+				}
+				current = result
+				continue
+			}
+
 			// Reflection fallback for other types
 			objVal := reflect.ValueOf(current)
 
-			// Handle pointer dereferencing
-			for objVal.Kind() == reflect.Ptr {
+			// Handle pointer and interface dereferencing
+			for objVal.Kind() == reflect.Ptr || objVal.Kind() == reflect.Interface {
 				if objVal.IsNil() {
 					return nil, ErrNilPointer
 				}
@@ -211,7 +308,10 @@ func find(val any, path Path) (*Reference, error) {
 
 			case reflect.Map:
 				// Map access using reflection
-				mapKey := reflect.ValueOf(key)
+				mapKey, ok := mapKeyOf(objVal, key)
+				if !ok {
+					return nil, ErrNotFound
+				}
 				mapVal := objVal.MapIndex(mapKey)
 				if mapVal.IsValid() {
 					current = mapVal.Interface()
@@ -237,5 +337,13 @@ func find(val any, path Path) (*Reference, error) {
 		}
 	}
 
-	return &Reference{Val: current, Obj: obj, Key: key}, nil
+	if lazy, ok := asLazy(current); ok {
+		resolved, err := resolveLazy(lazy, path)
+		if err != nil {
+			return nil, err
+		}
+		current = resolved
+	}
+
+	return &Reference{Val: current, Obj: obj, Key: key, path: path, root: val}, nil
 }
@@ -1,6 +1,10 @@
 package jsonpointer
 
 import (
+	"encoding/json"
+	"errors"
+	"iter"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -167,6 +171,106 @@ func TestFindByPointer(t *testing.T) {
 	})
 }
 
+// TestResolveByPointer tests allocation-free resolution against FindByPointer.
+func TestResolveByPointer(t *testing.T) {
+	doc := map[string]any{
+		"users": []any{
+			map[string]any{"name": "Alice", "a/b": 1},
+		},
+		"profile": &Profile{Location: "Tokyo"},
+	}
+
+	pointers := []string{
+		"", "/users", "/users/0/name", "/users/0/a~1b", "/profile/location",
+		"/users/1", "/users/-", "/users/01", "/missing", "/profile/nope", "/users/0/name/x",
+	}
+	for _, pointer := range pointers {
+		t.Run(pointer, func(t *testing.T) {
+			expected, expectedErr := FindByPointer(doc, pointer)
+
+			val, err := ResolveByPointer(doc, pointer)
+			assert.Equal(t, expectedErr, err)
+
+			refVal, obj, key, refErr := ResolveRefByPointer(doc, pointer)
+			assert.Equal(t, expectedErr, refErr)
+
+			out := Reference{Val: "stale"}
+			intoErr := FindByPointerInto(doc, pointer, &out)
+			assert.Equal(t, expectedErr, intoErr)
+
+			if expectedErr == nil {
+				assert.Equal(t, expected.Val, val)
+				assert.Equal(t, expected.Val, refVal)
+				assert.Equal(t, expected.Obj, obj)
+				assert.Equal(t, expected.Key, key)
+				assert.Equal(t, *expected, out)
+			} else {
+				assert.Equal(t, Reference{Val: "stale"}, out)
+			}
+		})
+	}
+
+	t.Run("does not allocate", func(t *testing.T) {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = ResolveByPointer(doc, "/users/0/name")
+		})
+		assert.Zero(t, allocs)
+
+		var ref Reference
+		allocs = testing.AllocsPerRun(100, func() {
+			_ = FindByPointerInto(doc, "/users/0/name", &ref)
+		})
+		assert.Zero(t, allocs)
+	})
+}
+
+// TestGetPointerValuedMaps tests pointers to maps and arrays stored as map values.
+func TestGetPointerValuedMaps(t *testing.T) {
+	doc := map[string]any{
+		"a": &map[string]any{"b": 1},
+		"l": &[]any{&map[string]any{"c": "x"}},
+	}
+
+	t.Run("resolves through pointers", func(t *testing.T) {
+		val, err := Get(doc, "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+
+		val, err = GetByPointer(doc, "/l/0/c")
+		assert.NoError(t, err)
+		assert.Equal(t, "x", val)
+
+		ref, err := FindByPointer(doc, "/a/b")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, ref.Val)
+		assert.Equal(t, "b", ref.Key)
+	})
+
+	t.Run("stays on the fast path", func(t *testing.T) {
+		current := any(doc)
+		for _, step := range []string{"l", "0", "c"} {
+			next, ok := fastGet(current, step)
+			if !ok {
+				t.Fatalf("fastGet(%q) fell back to the slow path", step)
+			}
+			current = next
+		}
+		assert.Equal(t, "x", current)
+	})
+
+	t.Run("does not allocate", func(t *testing.T) {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = Get(doc, "a", "b")
+		})
+		assert.Zero(t, allocs)
+
+		allocs = testing.AllocsPerRun(100, func() {
+			_, _ = Get(doc, "l", "0", "c")
+		})
+		assert.Zero(t, allocs)
+	})
+}
+
 // TestGet tests the get function that never throws errors.
 func TestGet(t *testing.T) {
 	t.Run("basic object access", func(t *testing.T) {
@@ -215,4 +319,988 @@ func TestGet(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "Alice", val)
 	})
+
+	t.Run("boxed maps", func(t *testing.T) {
+		var x any = map[string]any{"a": map[string]any{"b": 1}}
+		var y any = x
+		val, err := Get(y, "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+
+		// Nested interface pointers are unwrapped by the fast path
+		inner := &x
+		var outer any = inner
+		val, err = Get(&outer, "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+
+		val, err = Get(map[string]any{"p": &outer}, "p", "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+	})
+
+	t.Run("pointer chains to maps and slices", func(t *testing.T) {
+		m := map[string]any{"k": map[string]any{"v": 1}}
+		pm := &m
+		s := []any{map[string]any{"v": 2}}
+		ps := &s
+		var nilMap *map[string]any
+		doc := map[string]any{"m": &pm, "s": &ps, "nil": &nilMap}
+
+		for _, path := range []Path{{"m", "k", "v"}, {"s", "0", "v"}} {
+			val, err := Get(doc, path...)
+			assert.NoError(t, err, path)
+			ref, err := Find(doc, path...)
+			assert.NoError(t, err, path)
+			assert.Equal(t, val, ref.Val, path)
+			ref, err = FindByPointer(doc, Format(path...))
+			assert.NoError(t, err, path)
+			assert.Equal(t, val, ref.Val, path)
+			resolved, err := New(Options{}).Get(doc, path...)
+			assert.NoError(t, err, path)
+			assert.Equal(t, val, resolved, path)
+		}
+
+		val, err := Get(&pm, "k", "v")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+
+		_, err = Get(doc, "nil", "k")
+		assert.Equal(t, ErrNilPointer, err)
+	})
+
+	t.Run("interface-typed struct fields", func(t *testing.T) {
+		type holder struct {
+			Meta  any  `json:"meta"`
+			Boxed *any `json:"boxed"`
+		}
+		var boxed any = []map[string]int{{"n": 7}}
+		doc := holder{
+			Meta:  map[string]any{"tags": map[string]any{"env": "prod"}},
+			Boxed: &boxed,
+		}
+
+		val, err := Get(doc, "meta", "tags", "env")
+		assert.NoError(t, err)
+		assert.Equal(t, "prod", val)
+
+		ref, err := Find(&doc, "boxed", "0", "n")
+		assert.NoError(t, err)
+		assert.Equal(t, 7, ref.Val)
+
+		ref, err = FindByPointer(doc, "/boxed/0/n")
+		assert.NoError(t, err)
+		assert.Equal(t, 7, ref.Val)
+
+		val, err = New(Options{}).GetByPointer(doc, "/boxed/0/n")
+		assert.NoError(t, err)
+		assert.Equal(t, 7, val)
+	})
+
+	t.Run("named string map keys", func(t *testing.T) {
+		type myKey string
+		doc := map[string]any{
+			"typed": map[myKey]any{"a": map[myKey]int{"b": 1}},
+			"ints":  map[int]any{1: "one"},
+		}
+
+		val, err := Get(doc, "typed", "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+
+		ref, err := Find(doc, "typed", "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, ref.Val)
+
+		ref, err = FindByPointer(doc, "/typed/a/b")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, ref.Val)
+
+		_, err = Get(doc, "typed", "missing")
+		assert.Equal(t, ErrKeyNotFound, err)
+
+		// Maps without string keys cannot be addressed
+		_, err = Get(doc, "ints", "1")
+		assert.Equal(t, ErrNotFound, err)
+		_, err = Find(doc, "ints", "1")
+		assert.Equal(t, ErrNotFound, err)
+		_, err = FindByPointer(doc, "/ints/1")
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("byte and rune slices", func(t *testing.T) {
+		doc := map[string]any{
+			"bytes": []byte("hi"),
+			"runes": []rune("hé"),
+		}
+
+		val, err := Get(doc, "bytes", "1")
+		assert.NoError(t, err)
+		assert.Equal(t, byte('i'), val)
+
+		ref, err := Find(doc, "runes", "1")
+		assert.NoError(t, err)
+		assert.Equal(t, 'é', ref.Val)
+
+		val, err = GetByPointer(doc, "/runes/0")
+		assert.NoError(t, err)
+		assert.Equal(t, 'h', val)
+
+		for _, path := range [][]string{{"bytes", "-"}, {"bytes", "2"}, {"runes", "-"}, {"runes", "5"}} {
+			_, err = Get(doc, path...)
+			assert.Equal(t, ErrIndexOutOfBounds, err, path)
+			_, err = Find(doc, path...)
+			assert.Equal(t, ErrIndexOutOfBounds, err, path)
+		}
+
+		_, err = Find(doc, "bytes", "01")
+		assert.Equal(t, ErrInvalidIndex, err)
+	})
+
+	t.Run("typed nested maps", func(t *testing.T) {
+		groups := map[string][]any{"admins": {"alice", "bob"}}
+		sections := map[string]map[string]any{"server": {"port": 8080}}
+
+		val, err := Get(groups, "admins", "1")
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", val)
+
+		ref, err := Find(groups, "admins")
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"alice", "bob"}, ref.Val)
+
+		val, err = Get(sections, "server", "port")
+		assert.NoError(t, err)
+		assert.Equal(t, 8080, val)
+
+		ref, err = Find(sections, "server", "port")
+		assert.NoError(t, err)
+		assert.Equal(t, 8080, ref.Val)
+		assert.Equal(t, map[string]any{"port": 8080}, ref.Obj)
+
+		_, err = Get(groups, "users", "0")
+		assert.Equal(t, ErrKeyNotFound, err)
+		_, err = Find(sections, "client")
+		assert.Equal(t, ErrKeyNotFound, err)
+		_, err = Get(sections, "server", "host")
+		assert.Equal(t, ErrKeyNotFound, err)
+	})
+}
+
+// lazyFunc adapts a function to the LazyValue interface.
+type lazyFunc func() (any, error)
+
+func (f lazyFunc) Resolve() (any, error) { return f() }
+
+var errFetch = errors.New("fetch failed")
+
+// TestLazyValue tests traversal through values resolved on demand.
+func TestLazyValue(t *testing.T) {
+	calls := 0
+	doc := map[string]any{
+		"remote": lazyFunc(func() (any, error) {
+			calls++
+			return map[string]any{
+				"items": []any{"a", lazyFunc(func() (any, error) { return "b", nil })},
+			}, nil
+		}),
+		"broken": lazyFunc(func() (any, error) { return nil, errFetch }),
+	}
+
+	t.Run("Get continues into resolved map", func(t *testing.T) {
+		val, err := Get(doc, "remote", "items", "0")
+		assert.NoError(t, err)
+		assert.Equal(t, "a", val)
+	})
+
+	t.Run("Find continues into resolved map", func(t *testing.T) {
+		ref, err := Find(doc, "remote", "items", "0")
+		assert.NoError(t, err)
+		assert.Equal(t, "a", ref.Val)
+		assert.Equal(t, "0", ref.Key)
+	})
+
+	t.Run("FindByPointer continues into resolved map", func(t *testing.T) {
+		ref, err := FindByPointer(doc, "/remote/items/0")
+		assert.NoError(t, err)
+		assert.Equal(t, "a", ref.Val)
+	})
+
+	t.Run("lazy leaf is resolved", func(t *testing.T) {
+		val, err := GetByPointer(doc, "/remote/items/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "b", val)
+
+		ref, err := Find(doc, "remote", "items", "1")
+		assert.NoError(t, err)
+		assert.Equal(t, "b", ref.Val)
+	})
+
+	t.Run("Resolver resolves lazy values", func(t *testing.T) {
+		val, err := New(Options{}).GetByPointer(doc, "/remote/items/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "b", val)
+	})
+
+	t.Run("resolution error is wrapped with pointer", func(t *testing.T) {
+		_, err := Get(doc, "broken", "x")
+		assert.ErrorIs(t, err, errFetch)
+		assert.Contains(t, err.Error(), `"/broken"`)
+
+		_, err = Find(doc, "broken")
+		assert.ErrorIs(t, err, errFetch)
+
+		_, err = FindByPointer(doc, "/broken/x")
+		assert.ErrorIs(t, err, errFetch)
+		assert.Contains(t, err.Error(), `"/broken"`)
+	})
+
+	t.Run("error pointer is canonical", func(t *testing.T) {
+		escaped := map[string]any{
+			"a/b": map[string]any{"c~d": lazyFunc(func() (any, error) { return nil, errFetch })},
+		}
+		expected := strconv.Quote(Format("a/b", "c~d"))
+
+		_, err := Find(escaped, "a/b", "c~d", "x")
+		assert.ErrorIs(t, err, errFetch)
+		assert.Contains(t, err.Error(), expected)
+
+		_, err = Get(escaped, "a/b", "c~d", "x")
+		assert.Contains(t, err.Error(), expected)
+
+		_, err = FindByPointer(escaped, "/a~1b/c~0d/x")
+		assert.Contains(t, err.Error(), expected)
+
+		_, err = New(Options{}).Find(escaped, "a/b", "c~d", "x")
+		assert.Contains(t, err.Error(), expected)
+	})
+
+	assert.Positive(t, calls)
+}
+
+// valueLazy implements LazyValue with a value receiver.
+type valueLazy struct {
+	val any
+}
+
+func (l valueLazy) Resolve() (any, error) { return l.val, nil }
+
+// TestLazyValueNilPointer tests that nil pointers to lazy values with value
+// receivers are returned as values instead of being resolved.
+func TestLazyValueNilPointer(t *testing.T) {
+	type holder struct {
+		L *valueLazy `json:"l"`
+	}
+	doc := holder{}
+
+	val, err := GetByPointer(doc, "/l")
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+
+	ref, err := FindByPointer(doc, "/l")
+	assert.NoError(t, err)
+	assert.Nil(t, ref.Val)
+
+	ref, err = Find(doc, "l")
+	assert.NoError(t, err)
+	assert.Nil(t, ref.Val)
+
+	val, err = New(Options{}).GetByPointer(doc, "/l")
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+
+	_, err = GetByPointer(doc, "/l/x")
+	assert.Equal(t, ErrNilPointer, err)
+	_, err = FindByPointer(doc, "/l/x")
+	assert.Equal(t, ErrNilPointer, err)
+	_, err = New(Options{}).GetByPointer(doc, "/l/x")
+	assert.Equal(t, ErrNilPointer, err)
+}
+
+// lazyStruct is a struct LazyValue whose own fields differ from its resolution.
+type lazyStruct struct {
+	Name string `json:"name"`
+	err  error
+}
+
+func (l lazyStruct) Resolve() (any, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	return map[string]any{"name": "resolved"}, nil
+}
+
+// TestLazyValueStruct tests that struct lazy values are resolved before
+// stepping into them, consistently across Get and Find.
+func TestLazyValueStruct(t *testing.T) {
+	doc := map[string]any{
+		"ok":     lazyStruct{Name: "own"},
+		"broken": &lazyStruct{Name: "own", err: errFetch},
+	}
+
+	val, err := Get(doc, "ok", "name")
+	assert.NoError(t, err)
+	assert.Equal(t, "resolved", val)
+	ref, err := Find(doc, "ok", "name")
+	assert.NoError(t, err)
+	assert.Equal(t, val, ref.Val)
+
+	_, err = Get(doc, "broken", "name")
+	assert.ErrorIs(t, err, errFetch)
+	_, err = GetByPointer(doc, "/broken/name")
+	assert.ErrorIs(t, err, errFetch)
+	_, err = Find(doc, "broken", "name")
+	assert.ErrorIs(t, err, errFetch)
+}
+
+// selfLazy is a LazyValue that resolves to itself.
+type selfLazy struct{}
+
+func (s selfLazy) Resolve() (any, error) { return s, nil }
+
+// TestLazyValueCycle tests that lazy values resolving to themselves or to each
+// other fail instead of hanging.
+func TestLazyValueCycle(t *testing.T) {
+	var ping, pong lazyFunc
+	ping = func() (any, error) { return pong, nil }
+	pong = func() (any, error) { return ping, nil }
+	doc := map[string]any{"s": selfLazy{}, "p": ping}
+
+	_, err := Get(doc, "s")
+	assert.ErrorIs(t, err, ErrLazyResolutionLimit)
+	assert.Contains(t, err.Error(), `"/s"`)
+
+	_, err = Find(doc, "s", "x")
+	assert.ErrorIs(t, err, ErrLazyResolutionLimit)
+
+	_, err = GetByPointer(doc, "/p/x")
+	assert.ErrorIs(t, err, ErrLazyResolutionLimit)
+
+	_, err = New(Options{}).Get(doc, "p")
+	assert.ErrorIs(t, err, ErrLazyResolutionLimit)
+}
+
+// streamedRecord yields its fields as a sequence and counts how many were consumed.
+type streamedRecord struct {
+	keys     []string
+	values   []any
+	consumed int
+}
+
+func (r *streamedRecord) Seq() iter.Seq2[string, any] {
+	return func(yield func(string, any) bool) {
+		for i, key := range r.keys {
+			r.consumed++
+			if !yield(key, r.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// TestSequence tests traversal through range-over-func sequences.
+func TestSequence(t *testing.T) {
+	newRecord := func() *streamedRecord {
+		return &streamedRecord{
+			keys:   []string{"id", "tags", "meta"},
+			values: []any{7, []any{"a", "b"}, map[string]any{"ok": true}},
+		}
+	}
+
+	t.Run("resolves children lazily", func(t *testing.T) {
+		rec := newRecord()
+		doc := map[string]any{"rec": rec}
+
+		val, err := Get(doc, "rec", "tags", "1")
+		assert.NoError(t, err)
+		assert.Equal(t, "b", val)
+		// Iteration stops at the matching key
+		assert.Equal(t, 2, rec.consumed)
+
+		ref, err := Find(doc, "rec", "meta", "ok")
+		assert.NoError(t, err)
+		assert.Equal(t, true, ref.Val)
+
+		ref, err = FindByPointer(doc, "/rec/id")
+		assert.NoError(t, err)
+		assert.Equal(t, 7, ref.Val)
+		assert.Same(t, rec, ref.Obj)
+
+		val, err = New(Options{}).GetByPointer(rec, "/tags/0")
+		assert.NoError(t, err)
+		assert.Equal(t, "a", val)
+	})
+
+	t.Run("missing key consumes the sequence", func(t *testing.T) {
+		rec := newRecord()
+		_, err := FindByPointer(rec, "/missing")
+		assert.Equal(t, ErrFieldNotFound, err)
+		assert.Equal(t, 3, rec.consumed)
+
+		_, err = Get(rec, "missing")
+		assert.Equal(t, ErrFieldNotFound, err)
+	})
+}
+
+// dynamicRecord stores decoded data internally and exposes it through FieldAccessor.
+type dynamicRecord struct {
+	Name string `json:"name"` // shadowed by the accessor
+	data map[string]any
+}
+
+func (r *dynamicRecord) Field(name string) (any, bool) {
+	val, ok := r.data[name]
+	return val, ok
+}
+
+// TestFieldAccessor tests traversal through types exposing fields via methods.
+func TestFieldAccessor(t *testing.T) {
+	record := &dynamicRecord{
+		Name: "reflected",
+		data: map[string]any{
+			"name": "accessed",
+			"tags": []any{"x", "y"},
+			"a/b":  "escaped",
+		},
+	}
+	doc := map[string]any{"record": record}
+
+	t.Run("all entry points use the accessor", func(t *testing.T) {
+		val, err := Get(doc, "record", "name")
+		assert.NoError(t, err)
+		assert.Equal(t, "accessed", val)
+
+		val, err = GetByPointer(doc, "/record/tags/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "y", val)
+
+		ref, err := Find(doc, "record", "tags", "0")
+		assert.NoError(t, err)
+		assert.Equal(t, "x", ref.Val)
+
+		ref, err = FindByPointer(doc, "/record/name")
+		assert.NoError(t, err)
+		assert.Equal(t, "accessed", ref.Val)
+		assert.Equal(t, record, ref.Obj)
+		assert.Equal(t, "name", ref.Key)
+
+		val, err = New(Options{}).GetByPointer(doc, "/record/name")
+		assert.NoError(t, err)
+		assert.Equal(t, "accessed", val)
+	})
+
+	t.Run("pointer keys are unescaped", func(t *testing.T) {
+		ref, err := FindByPointer(doc, "/record/a~1b")
+		assert.NoError(t, err)
+		assert.Equal(t, "escaped", ref.Val)
+	})
+
+	t.Run("missing field", func(t *testing.T) {
+		_, err := Get(doc, "record", "missing")
+		assert.Equal(t, ErrFieldNotFound, err)
+
+		_, err = Find(doc, "record", "missing")
+		assert.Equal(t, ErrFieldNotFound, err)
+
+		_, err = FindByPointer(doc, "/record/missing")
+		assert.Equal(t, ErrFieldNotFound, err)
+
+		_, err = New(Options{}).Get(doc, "record", "missing")
+		assert.Equal(t, ErrFieldNotFound, err)
+	})
+}
+
+// valueAccessor implements FieldAccessor with a value receiver.
+type valueAccessor struct {
+	data map[string]any
+}
+
+func (v valueAccessor) Field(name string) (any, bool) {
+	val, ok := v.data[name]
+	return val, ok
+}
+
+// TestFieldAccessorNilPointer tests that nil pointers to accessors with value
+// receivers fail with ErrNilPointer instead of panicking.
+func TestFieldAccessorNilPointer(t *testing.T) {
+	type holder struct {
+		P *valueAccessor `json:"p"`
+	}
+	doc := holder{}
+
+	_, err := Get(doc, "p", "a")
+	assert.Equal(t, ErrNilPointer, err)
+	_, err = Get(map[string]any{"p": doc.P}, "p", "a")
+	assert.Equal(t, ErrNilPointer, err)
+	_, err = Find(doc, "p", "a")
+	assert.Equal(t, ErrNilPointer, err)
+	_, err = FindByPointer(doc, "/p/a")
+	assert.Equal(t, ErrNilPointer, err)
+	_, err = New(Options{}).Get(doc, "p", "a")
+	assert.Equal(t, ErrNilPointer, err)
+
+	refs, err := GetAll(doc, "/p/a")
+	assert.NoError(t, err)
+	assert.Empty(t, refs)
+
+	accessor, err := CompileAccessor[holder]("/p/a")
+	assert.NoError(t, err)
+	_, err = accessor(&doc)
+	assert.Equal(t, ErrNilPointer, err)
+
+	// The nil pointer itself is still a value
+	val, err := Get(doc, "p")
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+}
+
+// TestDashKey tests that "-" is an ordinary key for objects and the array end marker only for arrays.
+func TestDashKey(t *testing.T) {
+	type dashed struct {
+		Dash    string `json:"-,"`
+		Ignored string `json:"-"`
+	}
+
+	objects := map[string]any{
+		"map[string]any":    map[string]any{"-": "dash"},
+		"map[string]string": map[string]string{"-": "dash"},
+		"*map[string]any":   &map[string]any{"-": "dash"},
+		"struct tag -,":     dashed{Dash: "dash", Ignored: "ignored"},
+		"*struct tag -,":    &dashed{Dash: "dash", Ignored: "ignored"},
+	}
+
+	for name, doc := range objects {
+		t.Run(name, func(t *testing.T) {
+			ref, err := FindByPointer(doc, "/-")
+			assert.NoError(t, err)
+			assert.Equal(t, "dash", ref.Val)
+			assert.Equal(t, "-", ref.Key)
+
+			ref, err = Find(doc, "-")
+			assert.NoError(t, err)
+			assert.Equal(t, "dash", ref.Val)
+
+			val, err := Get(doc, "-")
+			assert.NoError(t, err)
+			assert.Equal(t, "dash", val)
+		})
+	}
+
+	t.Run("array end marker on arrays", func(t *testing.T) {
+		doc := map[string]any{"arr": []any{1, 2, 3}, "-": []any{"x"}}
+
+		_, err := FindByPointer(doc, "/arr/-")
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+		_, err = Find(doc, "arr", "-")
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+		_, err = Get(doc, "arr", "-")
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+
+		val, err := GetByPointer(doc, "/-/0")
+		assert.NoError(t, err)
+		assert.Equal(t, "x", val)
+	})
+
+	t.Run("nil pointer to map", func(t *testing.T) {
+		var m *map[string]any
+		_, err := FindByPointer(m, "/-")
+		assert.Equal(t, ErrNilPointer, err)
+	})
+}
+
+// TestNumericKeys tests that the container type alone decides whether a component
+// is a key or an index.
+func TestNumericKeys(t *testing.T) {
+	type indexed struct {
+		Zero string `json:"0"`
+	}
+	doc := map[string]any{
+		"object": map[string]any{"0": "key zero", "00": "key double zero", "-": "key dash", "-1": "key minus one"},
+		"typed":  map[string]string{"0": "typed key zero"},
+		"struct": indexed{Zero: "field zero"},
+		"array":  []any{"index zero", "index one"},
+	}
+
+	resolves := map[string]any{
+		"/object/0":  "key zero",
+		"/object/00": "key double zero",
+		"/object/-":  "key dash",
+		"/object/-1": "key minus one",
+		"/typed/0":   "typed key zero",
+		"/struct/0":  "field zero",
+		"/array/0":   "index zero",
+		"/array/1":   "index one",
+	}
+	for pointer, expected := range resolves {
+		t.Run(pointer, func(t *testing.T) {
+			val, err := GetByPointer(doc, pointer)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, val)
+
+			val, err = Get(doc, Parse(pointer)...)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, val)
+
+			ref, err := FindByPointer(doc, pointer)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, ref.Val)
+
+			ref, err = Find(doc, Parse(pointer)...)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, ref.Val)
+
+			val, err = New(Options{}).GetByPointer(doc, pointer)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, val)
+		})
+	}
+
+	fails := map[string]error{
+		"/array/00":  ErrInvalidIndex,
+		"/array/-1":  ErrInvalidIndex,
+		"/array/1e0": ErrInvalidIndex,
+		"/array/-":   ErrIndexOutOfBounds,
+		"/object/1":  ErrKeyNotFound,
+	}
+	for pointer, expected := range fails {
+		t.Run(pointer, func(t *testing.T) {
+			_, err := GetByPointer(doc, pointer)
+			assert.ErrorIs(t, err, expected)
+
+			_, err = Find(doc, Parse(pointer)...)
+			assert.ErrorIs(t, err, expected)
+
+			_, err = New(Options{}).GetByPointer(doc, pointer)
+			assert.ErrorIs(t, err, expected)
+		})
+	}
+
+	t.Run("set", func(t *testing.T) {
+		object := map[string]any{}
+		assert.NoError(t, Set(object, "v", "0"))
+		assert.Equal(t, map[string]any{"0": "v"}, object)
+
+		array := []any{"a"}
+		assert.NoError(t, Set(array, "v", "0"))
+		assert.Equal(t, []any{"v"}, array)
+	})
+}
+
+// TestEmptyKey tests that a trailing slash resolves the empty-string key (RFC 6901).
+func TestEmptyKey(t *testing.T) {
+	doc := map[string]any{
+		"foo":    map[string]any{"": "v"},
+		"nested": map[string]any{"": map[string]any{"": "deep"}},
+		"typed":  &map[string]string{"": "typed"},
+		"none":   map[string]any{"a": 1},
+	}
+
+	assert.Equal(t, Path{"foo", ""}, Parse("/foo/"))
+
+	tests := []struct {
+		pointer  string
+		expected any
+	}{
+		{"/foo/", "v"},
+		{"/nested//", "deep"},
+		{"/typed/", "typed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pointer, func(t *testing.T) {
+			path := Parse(tt.pointer)
+
+			val, err := GetByPointer(doc, tt.pointer)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, val)
+
+			val, err = Get(doc, path...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, val)
+
+			ref, err := FindByPointer(doc, tt.pointer)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, ref.Val)
+			assert.Empty(t, ref.Key)
+
+			ref, err = Find(doc, path...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, ref.Val)
+
+			val, err = New(Options{}).GetByPointer(doc, tt.pointer)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, val)
+		})
+	}
+
+	t.Run("missing empty key", func(t *testing.T) {
+		_, err := GetByPointer(doc, "/none/")
+		assert.Equal(t, ErrKeyNotFound, err)
+		_, err = FindByPointer(doc, "/none/")
+		assert.Equal(t, ErrKeyNotFound, err)
+	})
+
+	t.Run("set empty key", func(t *testing.T) {
+		target := map[string]any{"foo": map[string]any{}}
+		assert.NoError(t, Set(target, "w", "foo", ""))
+		assert.Equal(t, map[string]any{"foo": map[string]any{"": "w"}}, target)
+	})
+}
+
+func TestArrayIndexAtLength(t *testing.T) {
+	doc := map[string]any{"arr": []any{1, 2, 3}, "typed": []int{1, 2, 3}}
+
+	// One past the end is never resolved; IsAddressable reports it as an append position
+	for _, pointer := range []string{"/arr/3", "/typed/3"} {
+		_, err := FindByPointer(doc, pointer)
+		assert.Equal(t, ErrIndexOutOfBounds, err, pointer)
+		_, err = Find(doc, Parse(pointer)...)
+		assert.Equal(t, ErrIndexOutOfBounds, err, pointer)
+		_, err = GetByPointer(doc, pointer)
+		assert.Equal(t, ErrIndexOutOfBounds, err, pointer)
+		_, err = New(Options{}).FindByPointer(doc, pointer)
+		assert.Equal(t, ErrIndexOutOfBounds, err, pointer)
+		assert.True(t, IsAddressable(doc, pointer), pointer)
+	}
+}
+
+func TestArrayEndNotFinal(t *testing.T) {
+	doc := map[string]any{
+		"arr":  []any{map[string]any{"x": 1}},
+		"list": []string{"a"},
+		"obj":  map[string]any{"-": map[string]any{"x": 2}},
+	}
+
+	_, err := Get(doc, "arr", "-", "x")
+	assert.ErrorIs(t, err, ErrArrayEndNotFinal)
+	_, err = GetByPointer(doc, "/arr/-/x")
+	assert.ErrorIs(t, err, ErrArrayEndNotFinal)
+	_, err = Find(doc, "arr", "-", "x")
+	assert.ErrorIs(t, err, ErrArrayEndNotFinal)
+	_, err = FindByPointer(doc, "/arr/-/x")
+	assert.ErrorIs(t, err, ErrArrayEndNotFinal)
+	_, err = ResolveByPointer(doc, "/list/-/0")
+	assert.ErrorIs(t, err, ErrArrayEndNotFinal)
+
+	r := New(Options{})
+	_, err = r.Find(doc, "arr", "-", "x")
+	assert.ErrorIs(t, err, ErrArrayEndNotFinal)
+	_, err = r.Bind(doc).Get("/arr/-/x")
+	assert.ErrorIs(t, err, ErrArrayEndNotFinal)
+
+	// The final position and object keys named "-" are unaffected
+	_, err = FindByPointer(doc, "/arr/-")
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+	val, err := GetByPointer(doc, "/obj/-/x")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, val)
+}
+
+// configRoot wraps named configuration sections and resolves them as the root.
+type configRoot struct {
+	sections map[string]map[string]any
+}
+
+func (c configRoot) Child(key string) (any, bool) {
+	section, ok := c.sections[key]
+	return section, ok
+}
+
+// TestRoot tests customizing resolution of the first path component.
+func TestRoot(t *testing.T) {
+	doc := configRoot{sections: map[string]map[string]any{
+		"server": {"port": 8080, "hosts": []any{"a", "b"}},
+	}}
+
+	t.Run("first hop uses Child", func(t *testing.T) {
+		val, err := Get(doc, "server", "port")
+		assert.NoError(t, err)
+		assert.Equal(t, 8080, val)
+
+		val, err = GetByPointer(doc, "/server/hosts/1")
+		assert.NoError(t, err)
+		assert.Equal(t, "b", val)
+
+		val, err = GetAny(doc, Path{"server", "port"})
+		assert.NoError(t, err)
+		assert.Equal(t, 8080, val)
+
+		ref, err := Find(doc, "server", "hosts", "0")
+		assert.NoError(t, err)
+		assert.Equal(t, "a", ref.Val)
+		assert.Equal(t, "0", ref.Key)
+
+		ref, err = FindByPointer(doc, "/server/port")
+		assert.NoError(t, err)
+		assert.Equal(t, 8080, ref.Val)
+	})
+
+	t.Run("reference to a direct child", func(t *testing.T) {
+		ref, err := Find(doc, "server")
+		assert.NoError(t, err)
+		assert.Equal(t, doc, ref.Obj)
+		assert.Equal(t, "server", ref.Key)
+		assert.Equal(t, doc.sections["server"], ref.Val)
+	})
+
+	t.Run("root pointer returns the document", func(t *testing.T) {
+		val, err := GetByPointer(doc, "")
+		assert.NoError(t, err)
+		assert.Equal(t, doc, val)
+	})
+
+	t.Run("missing child", func(t *testing.T) {
+		_, err := Get(doc, "client", "port")
+		assert.Equal(t, ErrKeyNotFound, err)
+
+		_, err = FindByPointer(doc, "/client")
+		assert.Equal(t, ErrKeyNotFound, err)
+
+		_, err = Get(doc, "server", "missing")
+		assert.Equal(t, ErrKeyNotFound, err)
+	})
+}
+
+// TestArrayIndexFormat tests that every entry point rejects non-canonical array indices alike.
+func TestArrayIndexFormat(t *testing.T) {
+	docs := map[string]any{
+		"[]any":       []any{"a", "b"},
+		"*[]any":      &[]any{"a", "b"},
+		"[]string":    []string{"a", "b"},
+		"[]byte":      []byte("ab"),
+		"[]User":      []User{{}, {}},
+		"[2]int":      [2]int{1, 2},
+		"map wrapped": map[string]any{"items": []any{"a", "b"}},
+	}
+	indices := []string{"01", "-0", "+1", " 1", "1 ", "00", "1e0", "0x1", "-1"}
+
+	for name, doc := range docs {
+		prefix := Path{}
+		if name == "map wrapped" {
+			prefix = Path{"items"}
+		}
+		for _, index := range indices {
+			t.Run(name+"/"+index, func(t *testing.T) {
+				path := append(prefix.Clone(), index)
+
+				_, err := Get(doc, path...)
+				assert.Equal(t, ErrInvalidIndex, err, "Get")
+
+				_, err = Find(doc, path...)
+				assert.Equal(t, ErrInvalidIndex, err, "Find")
+
+				_, err = FindByPointer(doc, Format(path...))
+				assert.Equal(t, ErrInvalidIndex, err, "FindByPointer")
+
+				_, err = New(Options{}).Get(doc, path...)
+				assert.Equal(t, ErrInvalidIndex, err, "Resolver.Get")
+			})
+		}
+	}
+}
+
+// TestRawMessage tests traversal into partially decoded JSON.
+func TestRawMessage(t *testing.T) {
+	type response struct {
+		Items json.RawMessage `json:"items"`
+	}
+	doc := map[string]any{
+		"items":    json.RawMessage(`["a", {"b": 2}, 3]`),
+		"object":   json.RawMessage(`{"x": {"y": true}}`),
+		"invalid":  json.RawMessage(`{`),
+		"response": response{Items: json.RawMessage(`[10, 20]`)},
+	}
+
+	t.Run("array elements", func(t *testing.T) {
+		val, err := GetByPointer(doc, "/items/1")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"b": 2.0}, val)
+
+		val, err = Get(doc, "items", "1", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, 2.0, val)
+
+		ref, err := Find(doc, "items", "2")
+		assert.NoError(t, err)
+		assert.Equal(t, 3.0, ref.Val)
+
+		ref, err = FindByPointer(doc, "/items/0")
+		assert.NoError(t, err)
+		assert.Equal(t, "a", ref.Val)
+
+		val, err = New(Options{}).GetByPointer(doc, "/items/1/b")
+		assert.NoError(t, err)
+		assert.Equal(t, 2.0, val)
+
+		val, err = GetByPointer(doc, "/response/items/1")
+		assert.NoError(t, err)
+		assert.Equal(t, 20.0, val)
+	})
+
+	t.Run("object members", func(t *testing.T) {
+		val, err := GetByPointer(doc, "/object/x/y")
+		assert.NoError(t, err)
+		assert.Equal(t, true, val)
+
+		ref, err := FindByPointer(doc, "/object/x")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"y": true}, ref.Val)
+	})
+
+	t.Run("target raw message is not decoded", func(t *testing.T) {
+		val, err := GetByPointer(doc, "/items")
+		assert.NoError(t, err)
+		assert.Equal(t, json.RawMessage(`["a", {"b": 2}, 3]`), val)
+	})
+
+	t.Run("maps of raw messages decode only the selected entry", func(t *testing.T) {
+		// Sibling entries hold invalid JSON, so decoding any of them would fail
+		rawDoc := map[string]json.RawMessage{
+			"a":      json.RawMessage(`{"b": {"c": 1}}`),
+			"broken": json.RawMessage(`{`),
+			"other":  json.RawMessage(`[`),
+		}
+
+		val, err := GetByPointer(rawDoc, "/a/b/c")
+		assert.NoError(t, err)
+		assert.Equal(t, 1.0, val)
+
+		val, err = Get(rawDoc, "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"c": 1.0}, val)
+
+		ref, err := Find(rawDoc, "a", "b", "c")
+		assert.NoError(t, err)
+		assert.Equal(t, 1.0, ref.Val)
+
+		ref, err = FindByPointer(rawDoc, "/a")
+		assert.NoError(t, err)
+		assert.Equal(t, json.RawMessage(`{"b": {"c": 1}}`), ref.Val)
+
+		_, err = Find(rawDoc, "missing", "x")
+		assert.Equal(t, ErrKeyNotFound, err)
+	})
+
+	t.Run("traversal errors", func(t *testing.T) {
+		_, err := GetByPointer(doc, "/items/3")
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+
+		_, err = Find(doc, "object", "missing")
+		assert.Equal(t, ErrKeyNotFound, err)
+
+		var syntaxErr *json.SyntaxError
+		_, err = GetByPointer(doc, "/invalid/x")
+		assert.ErrorAs(t, err, &syntaxErr)
+		_, err = FindByPointer(doc, "/invalid/x")
+		assert.ErrorAs(t, err, &syntaxErr)
+	})
+
+	t.Run("wildcards enumerate decoded children", func(t *testing.T) {
+		count, err := CountMatches(doc, "/items/*")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+
+		n, err := NumChildren(doc, "/object")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
 }
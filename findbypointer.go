@@ -39,9 +39,19 @@ import (
 //	  }
 //	  return {val, obj, key};
 //	};
-func findByPointer(pointer string, val any) (*Reference, error) {
+func findByPointer(pointer string, doc any) (*Reference, error) {
+	val, obj, key, err := resolveByPointer(pointer, doc)
+	if err != nil {
+		return nil, err
+	}
+	return &Reference{Val: val, Obj: obj, Key: key, pointer: pointer, root: doc}, nil
+}
+
+// resolveByPointer implements findByPointer, returning the reference fields
+// separately so callers that do not need a *Reference avoid allocating one.
+func resolveByPointer(pointer string, val any) (any, any, string, error) {
 	if pointer == "" {
-		return &Reference{Val: val}, nil
+		return val, nil, "", nil
 	}
 
 	var obj any
@@ -64,8 +74,52 @@ func findByPointer(pointer string, val any) (*Reference, error) {
 			keyStr = pointer[indexAfterSlash:]
 		}
 
+		// Lazy values are resolved before traversing into them
+		if lazy, ok := asSteppable(val); ok {
+			resolved, err := resolveLazy(lazy, parseJsonPointer(pointer[:indexAfterSlash-1]))
+			if err != nil {
+				return nil, nil, "", err
+			}
+			val = resolved
+		}
+
 		indexAfterSlash = indexOfSlash + 1
 		obj = val
+		if isArrayEndMidPath(obj, keyStr, indexOfSlash == -1) {
+			return nil, nil, "", ErrArrayEndNotFinal
+		}
+
+		// Types implementing FieldAccessor or Sequence provide their own field lookup
+		if accessor, ok := asFieldAccessor(obj); ok {
+			key = unescapeComponent(keyStr)
+			result, exists := accessor.Field(key)
+			if !exists {
+				return nil, nil, "", ErrFieldNotFound
+			}
+			val = result
+			continue
+		}
+
+		// Fast paths for the most common decoded JSON shapes avoid reflection
+		switch v := obj.(type) {
+		case map[string]any:
+			key = unescapeComponent(keyStr)
+			result, exists := v[key]
+			if !exists {
+				return nil, nil, "", ErrKeyNotFound
+			}
+			val = result
+			continue
+
+		case []any:
+			index, err := arrayIndex(keyStr, len(v))
+			if err != nil {
+				return nil, nil, "", err
+			}
+			key = keyStr
+			val = v[index]
+			continue
+		}
 
 		switch {
 		case func() bool {
@@ -73,8 +127,8 @@ func findByPointer(pointer string, val any) (*Reference, error) {
 				return false
 			}
 			objVal := reflect.ValueOf(obj)
-			// Handle pointer dereferencing
-			for objVal.Kind() == reflect.Ptr {
+			// Handle pointer and interface dereferencing
+			for objVal.Kind() == reflect.Ptr || objVal.Kind() == reflect.Interface {
 				if objVal.IsNil() {
 					return false
 				}
@@ -85,10 +139,10 @@ func findByPointer(pointer string, val any) (*Reference, error) {
 		}():
 			// Handle array access
 			arrayVal := reflect.ValueOf(obj)
-			// Handle pointer dereferencing
-			for arrayVal.Kind() == reflect.Ptr {
+			// Handle pointer and interface dereferencing
+			for arrayVal.Kind() == reflect.Ptr || arrayVal.Kind() == reflect.Interface {
 				if arrayVal.IsNil() {
-					return nil, ErrNilPointer
+					return nil, nil, "", ErrNilPointer
 				}
 				arrayVal = arrayVal.Elem()
 			}
@@ -96,19 +150,19 @@ func findByPointer(pointer string, val any) (*Reference, error) {
 
 			if keyStr == "-" {
 				// "-" refers to nonexistent element (JSON Pointer spec)
-				return nil, ErrIndexOutOfBounds
+				return nil, nil, "", ErrIndexOutOfBounds
 			} else {
 				// Convert key to integer (~~key behavior in TypeScript)
 				keyInt, err := strconv.Atoi(keyStr)
 				if err != nil {
-					return nil, ErrInvalidIndex
+					return nil, nil, "", ErrInvalidIndex
 				}
 				// Check if string representation matches parsed value
 				if strconv.Itoa(keyInt) != keyStr {
-					return nil, ErrInvalidIndex
+					return nil, nil, "", ErrInvalidIndex
 				}
 				if keyInt < 0 {
-					return nil, ErrInvalidIndex
+					return nil, nil, "", ErrInvalidIndex
 				}
 
 				key = keyStr // Keep as string for Reference
@@ -118,9 +172,9 @@ func findByPointer(pointer string, val any) (*Reference, error) {
 				case keyInt < length:
 					val = arrayVal.Index(keyInt).Interface()
 				case keyInt == length:
-					return nil, ErrIndexOutOfBounds
+					return nil, nil, "", ErrIndexOutOfBounds
 				default:
-					return nil, ErrIndexOutOfBounds
+					return nil, nil, "", ErrIndexOutOfBounds
 				}
 			}
 		case isObjectPointer(obj) && obj != nil:
@@ -130,34 +184,48 @@ func findByPointer(pointer string, val any) (*Reference, error) {
 			key = keyStr
 
 			objVal := reflect.ValueOf(obj)
+			// Handle pointer dereferencing so pointers to maps use map access
+			for objVal.Kind() == reflect.Ptr || objVal.Kind() == reflect.Interface {
+				if objVal.IsNil() {
+					return nil, nil, "", ErrNilPointer
+				}
+				objVal = objVal.Elem()
+			}
 			if objVal.Kind() == reflect.Map {
 				// Handle map
-				mapKey := reflect.ValueOf(keyStr)
+				mapKey, ok := mapKeyOf(objVal, keyStr)
+				if !ok {
+					return nil, nil, "", ErrNotFound
+				}
 				mapVal := objVal.MapIndex(mapKey)
 				if mapVal.IsValid() {
 					val = mapVal.Interface()
 				} else {
-					return nil, ErrKeyNotFound // Key not found
+					return nil, nil, "", ErrKeyNotFound // Key not found
 				}
 			} else {
 				// Handle struct with optimized field lookup
 				if structField(keyStr, &objVal) {
 					val = objVal.Interface()
 				} else {
-					return nil, ErrFieldNotFound // Field not found
+					return nil, nil, "", ErrFieldNotFound // Field not found
 				}
 			}
 		default:
 			// Not an array or object, can't traverse further
-			return nil, ErrNotFound
+			return nil, nil, "", ErrNotFound
+		}
+	}
+
+	if lazy, ok := asLazy(val); ok {
+		resolved, err := resolveLazy(lazy, parseJsonPointer(pointer))
+		if err != nil {
+			return nil, nil, "", err
 		}
+		val = resolved
 	}
 
-	return &Reference{
-		Val: val,
-		Obj: obj,
-		Key: key,
-	}, nil
+	return val, obj, key, nil
 }
 
 // Helper function to check if value is an object (map or struct) for pointer operations
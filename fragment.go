@@ -0,0 +1,45 @@
+package jsonpointer
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ParseURIFragment parses a JSON Pointer in URI fragment identifier form (RFC 6901, section 6).
+// The fragment must start with "#"; the remainder is percent-decoded and parsed as a JSON Pointer.
+// A bare "#" refers to the whole document and yields the root (empty) Path.
+func ParseURIFragment(fragment string) (Path, error) {
+	if !strings.HasPrefix(fragment, "#") {
+		return nil, ErrPointerInvalid
+	}
+
+	pointer, err := url.PathUnescape(fragment[1:])
+	if err != nil {
+		return nil, ErrPointerInvalid
+	}
+	if err := validatePointerString(pointer); err != nil {
+		return nil, err
+	}
+	return parseJsonPointer(pointer), nil
+}
+
+// GetByFragment retrieves a value from document using a URI fragment JSON Pointer like "#/foo/bar".
+// GetByFragment(doc, "#") returns doc itself.
+func GetByFragment(doc any, fragment string) (any, error) {
+	path, err := ParseURIFragment(fragment)
+	if err != nil {
+		return nil, err
+	}
+	return get(doc, path)
+}
+
+// ResolveRef resolves an in-document JSON Schema "$ref" such as "#/definitions/User"
+// against root and returns the referenced subtree. Refs that are not bare fragments,
+// such as "other.json#/definitions/User" or absolute URLs, return ErrExternalRef so
+// callers can fetch and resolve the external document separately.
+func ResolveRef(root any, ref string) (any, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, ErrExternalRef
+	}
+	return GetByFragment(root, ref)
+}
@@ -0,0 +1,121 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseURIFragment tests parsing of URI fragment JSON Pointers.
+func TestParseURIFragment(t *testing.T) {
+	tests := []struct {
+		fragment string
+		expected Path
+	}{
+		{"#", Path{}},
+		{"#/", Path{""}},
+		{"#/foo", Path{"foo"}},
+		{"#/foo/0", Path{"foo", "0"}},
+		{"#/a~1b", Path{"a/b"}},
+		{"#/c%25d", Path{"c%d"}},
+		{"#/k%22l", Path{"k\"l"}},
+		{"#/%20", Path{" "}},
+		{"#/m~0n", Path{"m~n"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fragment, func(t *testing.T) {
+			path, err := ParseURIFragment(tt.fragment)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, path)
+		})
+	}
+
+	t.Run("missing hash", func(t *testing.T) {
+		_, err := ParseURIFragment("/foo")
+		assert.Equal(t, ErrPointerInvalid, err)
+	})
+
+	t.Run("missing leading slash", func(t *testing.T) {
+		_, err := ParseURIFragment("#foo")
+		assert.Equal(t, ErrPointerInvalid, err)
+	})
+
+	t.Run("invalid percent encoding", func(t *testing.T) {
+		_, err := ParseURIFragment("#/foo%zz")
+		assert.Equal(t, ErrPointerInvalid, err)
+	})
+}
+
+// TestGetByFragment tests value retrieval using URI fragment JSON Pointers.
+func TestGetByFragment(t *testing.T) {
+	doc := map[string]any{
+		"definitions": map[string]any{
+			"a b": map[string]any{"type": "string"},
+		},
+	}
+
+	t.Run("bare hash returns whole document", func(t *testing.T) {
+		val, err := GetByFragment(doc, "#")
+		assert.NoError(t, err)
+		assert.Equal(t, doc, val)
+	})
+
+	t.Run("percent-encoded key", func(t *testing.T) {
+		val, err := GetByFragment(doc, "#/definitions/a%20b/type")
+		assert.NoError(t, err)
+		assert.Equal(t, "string", val)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := GetByFragment(doc, "#/definitions/missing")
+		assert.Equal(t, ErrKeyNotFound, err)
+	})
+}
+
+func TestResolveRef(t *testing.T) {
+	schema := map[string]any{
+		"$ref": "#/definitions/User",
+		"definitions": map[string]any{
+			"User": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name": map[string]any{"type": "string"},
+				},
+			},
+		},
+	}
+
+	t.Run("fragment ref", func(t *testing.T) {
+		val, err := ResolveRef(schema, "#/definitions/User")
+		assert.NoError(t, err)
+		assert.Equal(t, schema["definitions"].(map[string]any)["User"], val)
+
+		val, err = ResolveRef(schema, "#/definitions/User/properties/name/type")
+		assert.NoError(t, err)
+		assert.Equal(t, "string", val)
+	})
+
+	t.Run("root ref", func(t *testing.T) {
+		val, err := ResolveRef(schema, "#")
+		assert.NoError(t, err)
+		assert.Equal(t, schema, val)
+	})
+
+	t.Run("external refs", func(t *testing.T) {
+		for _, ref := range []string{
+			"other.json#/definitions/User",
+			"https://example.com/schema.json",
+			"/definitions/User",
+			"",
+		} {
+			_, err := ResolveRef(schema, ref)
+			assert.ErrorIs(t, err, ErrExternalRef, ref)
+		}
+	})
+
+	t.Run("missing definition", func(t *testing.T) {
+		_, err := ResolveRef(schema, "#/definitions/Missing")
+		assert.Equal(t, ErrKeyNotFound, err)
+	})
+}
@@ -1,6 +1,7 @@
 package jsonpointer
 
 import (
+	"encoding/json"
 	"reflect"
 	"strconv"
 )
@@ -47,6 +48,34 @@ func fastGet(val any, step string) (any, bool) {
 		}
 		return (*v)[index], true
 
+	case **map[string]any:
+		// Pointer chains to maps unwrap one level and recurse
+		if v == nil {
+			return nil, false
+		}
+		return fastGet(*v, step)
+
+	case **[]any:
+		// Pointer chains to slices unwrap one level and recurse
+		if v == nil {
+			return nil, false
+		}
+		return fastGet(*v, step)
+
+	case map[string][]any:
+		// Nested decoded shapes, returned typed for the next step
+		result, exists := v[step]
+		return result, exists
+
+	case map[string]map[string]any:
+		result, exists := v[step]
+		return result, exists
+
+	case map[string]string:
+		// Typed string maps are common elements of mixed []any arrays
+		result, exists := v[step]
+		return result, exists
+
 	case *any:
 		// Interface pointer - recurse once
 		if v == nil {
@@ -54,10 +83,44 @@ func fastGet(val any, step string) (any, bool) {
 		}
 		return fastGet(*v, step)
 
+	case FieldAccessor:
+		if isNilPointer(v) {
+			return nil, false
+		}
+		return v.Field(step)
+
+	case Sequence:
+		if isNilPointer(v) {
+			return nil, false
+		}
+		return seqAccessor{v}.Field(step)
+
 	default:
-		// Fast path failed, need reflection fallback
+		// Lazy values must be resolved before stepping into them, which the
+		// general path handles
+		if _, ok := asSteppable(val); ok {
+			return nil, false
+		}
+		// Structs and pointers to structs (e.g. a *User stored in map[string]any)
+		// resolve directly through the cached field map
+		return fastStructField(val, step)
+	}
+}
+
+// fastStructField looks up a field of a struct or pointer to struct using the cached field map.
+// Returns false for any other value so the caller can fall back to the general path.
+func fastStructField(val any, step string) (any, bool) {
+	structVal := reflect.ValueOf(val)
+	if structVal.Kind() == reflect.Ptr {
+		if structVal.IsNil() {
+			return nil, false
+		}
+		structVal = structVal.Elem()
+	}
+	if structVal.Kind() != reflect.Struct || !structField(step, &structVal) {
 		return nil, false
 	}
+	return structVal.Interface(), true
 }
 
 // getTokenAtIndex computes an internalToken for a specific path step without allocating a slice.
@@ -167,12 +230,51 @@ func tryArrayAccess(current any, token internalToken) (any, bool, error) {
 			return nil, true, ErrIndexOutOfBounds
 		}
 
+	case []byte:
+		if token.key == "-" {
+			return nil, true, ErrIndexOutOfBounds // "-" refers to nonexistent element
+		}
+		if token.index < 0 || strconv.Itoa(token.index) != token.key {
+			return nil, true, ErrInvalidIndex
+		}
+		switch {
+		case token.index < len(arr):
+			return arr[token.index], true, nil
+		case token.index == len(arr):
+			// Array end position is nonexistent element (JSON Pointer spec)
+			return nil, true, ErrIndexOutOfBounds
+		default:
+			return nil, true, ErrIndexOutOfBounds
+		}
+
+	case []rune:
+		if token.key == "-" {
+			return nil, true, ErrIndexOutOfBounds // "-" refers to nonexistent element
+		}
+		if token.index < 0 || strconv.Itoa(token.index) != token.key {
+			return nil, true, ErrInvalidIndex
+		}
+		switch {
+		case token.index < len(arr):
+			return arr[token.index], true, nil
+		case token.index == len(arr):
+			// Array end position is nonexistent element (JSON Pointer spec)
+			return nil, true, ErrIndexOutOfBounds
+		default:
+			return nil, true, ErrIndexOutOfBounds
+		}
+
 	default:
+		// Slice types registered with RegisterSliceType avoid reflection
+		if result, handled, err := registeredSliceAccess(current, token); handled {
+			return result, true, err
+		}
+
 		// Fallback to reflection for other array types (like []User, native arrays, and pointers to arrays)
 		arrayVal := reflect.ValueOf(current)
 
-		// Handle pointer dereferencing
-		for arrayVal.Kind() == reflect.Ptr {
+		// Handle pointer and interface dereferencing
+		for arrayVal.Kind() == reflect.Ptr || arrayVal.Kind() == reflect.Interface {
 			if arrayVal.IsNil() {
 				return nil, true, ErrNilPointer
 			}
@@ -246,12 +348,54 @@ func tryObjectAccess(current any, token internalToken) (any, bool, error) {
 		}
 		return result, true, nil
 
+	case map[string][]any:
+		result, exists := obj[token.key]
+		if !exists {
+			return nil, true, ErrKeyNotFound // Key doesn't exist
+		}
+		return result, true, nil
+
+	case map[string]map[string]any:
+		result, exists := obj[token.key]
+		if !exists {
+			return nil, true, ErrKeyNotFound // Key doesn't exist
+		}
+		return result, true, nil
+
+	case map[string]json.RawMessage:
+		// Only the selected entry is decoded, when traversal continues into it
+		result, exists := obj[token.key]
+		if !exists {
+			return nil, true, ErrKeyNotFound // Key doesn't exist
+		}
+		return result, true, nil
+
+	case FieldAccessor:
+		if isNilPointer(obj) {
+			return nil, true, ErrNilPointer
+		}
+		result, exists := obj.Field(token.key)
+		if !exists {
+			return nil, true, ErrFieldNotFound
+		}
+		return result, true, nil
+
+	case Sequence:
+		if isNilPointer(obj) {
+			return nil, true, ErrNilPointer
+		}
+		result, exists := seqAccessor{obj}.Field(token.key)
+		if !exists {
+			return nil, true, ErrFieldNotFound
+		}
+		return result, true, nil
+
 	default:
 		// Fallback to reflection for other object types
 		objVal := reflect.ValueOf(current)
 
-		// Handle pointer dereferencing
-		for objVal.Kind() == reflect.Ptr {
+		// Handle pointer and interface dereferencing
+		for objVal.Kind() == reflect.Ptr || objVal.Kind() == reflect.Interface {
 			if objVal.IsNil() {
 				return nil, false, ErrNilPointer
 			}
@@ -260,7 +404,10 @@ func tryObjectAccess(current any, token internalToken) (any, bool, error) {
 
 		switch objVal.Kind() {
 		case reflect.Map:
-			mapKey := reflect.ValueOf(token.key)
+			mapKey, ok := mapKeyOf(objVal, token.key)
+			if !ok {
+				return nil, true, ErrNotFound
+			}
 			mapVal := objVal.MapIndex(mapKey)
 			if !mapVal.IsValid() {
 				return nil, true, ErrKeyNotFound // Key doesn't exist
@@ -318,9 +465,20 @@ func get(val any, path Path) (any, error) {
 			// Compute token on-demand only when needed
 			token := getTokenAtIndex(path, i)
 
+			if lazy, ok := asSteppable(current); ok {
+				resolved, err := resolveLazy(lazy, path[:i])
+				if err != nil {
+					return nil, err
+				}
+				current = resolved
+			}
+
 			if current == nil {
 				return nil, ErrNotFound
 			}
+			if isArrayEndMidPath(current, token.key, i == pathLength-1) {
+				return nil, ErrArrayEndNotFinal
+			}
 
 			// Try optimized array access first
 			if result, handled, err := tryArrayAccess(current, token); err != nil {
@@ -343,6 +501,9 @@ func get(val any, path Path) (any, error) {
 		}
 	}
 
+	if lazy, ok := asLazy(current); ok {
+		return resolveLazy(lazy, path)
+	}
 	return current, nil
 }
 
@@ -363,6 +524,9 @@ func findStructField(structVal reflect.Value, key string) reflect.Value {
 
 		// Check JSON tag
 		if jsonTag := field.Tag.Get("json"); jsonTag != "" {
+			if jsonTag == "-" {
+				continue // Explicitly ignored field
+			}
 			tagName := jsonTag
 			// Find comma to extract just the field name part
 			for j, r := range jsonTag {
@@ -371,16 +535,14 @@ func findStructField(structVal reflect.Value, key string) reflect.Value {
 					break
 				}
 			}
-			if tagName == key {
+			// A tag with options only (e.g. ",omitempty") keeps the field name
+			if tagName != "" && tagName == key {
 				return structVal.Field(i)
 			}
-			if tagName == "-" {
-				continue // Explicitly ignored field
-			}
 		}
 	}
 
-	// Second pass: look for field name match (if no JSON tag found)
+	// Second pass: look for field name match (if no JSON tag name found)
 	for i := 0; i < numFields; i++ {
 		field := structType.Field(i)
 
@@ -389,8 +551,8 @@ func findStructField(structVal reflect.Value, key string) reflect.Value {
 			continue
 		}
 
-		// Skip if has JSON tag (already checked above)
-		if field.Tag.Get("json") != "" {
+		// Skip if has JSON tag name (already checked above)
+		if jsonTag := field.Tag.Get("json"); jsonTag == "-" || (jsonTag != "" && jsonTag[0] != ',') {
 			continue
 		}
 
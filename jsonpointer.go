@@ -7,6 +7,12 @@
 //
 // TypeScript original source: https://github.com/jsonjoy-com/json-pointer
 //
+// The type of the container being traversed alone decides how a path component
+// is interpreted: maps and structs always treat it as a key, so "0", "00" and "-"
+// are ordinary keys, while slices and arrays always treat it as an index, so
+// "00", "-1" and "1e0" are invalid indices there. No component is ever guessed
+// to be a number or a key from its spelling.
+//
 // Usage:
 //
 //	import "github.com/kaptinlin/jsonpointer"
@@ -30,12 +36,22 @@
 //	err = jsonpointer.Validate("/users/0/name")
 package jsonpointer
 
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
 // Get retrieves a value from document using string path components.
 // Returns errors for invalid operations, similar to Find function.
 func Get(doc any, path ...string) (any, error) {
 	if len(path) == 0 {
 		return doc, nil
 	}
+	if root, ok := asRoot(doc); ok {
+		return getFromRoot(root, Path(path))
+	}
 	return get(doc, Path(path))
 }
 
@@ -43,31 +59,293 @@ func Get(doc any, path ...string) (any, error) {
 // Returns errors for invalid operations.
 func Find(doc any, path ...string) (*Reference, error) {
 	if len(path) == 0 {
-		return &Reference{Val: doc}, nil
+		return &Reference{Val: doc, root: doc}, nil
+	}
+	if root, ok := asRoot(doc); ok {
+		return findFromRoot(root, Path(path))
 	}
 	return find(doc, Path(path))
 }
 
+// FindWithPath locates a reference like Find and also returns the canonical Path
+// that was traversed. Steps may be strings or integers; integer steps are
+// normalized to their decimal string form, and negative integers return
+// ErrInvalidIndex. Integral float64 steps, as produced by decoding a JSON array
+// of steps, are treated like integers; other floats return ErrInvalidPathStep.
+// Any other step type returns ErrInvalidPathStep.
+func FindWithPath(doc any, steps ...any) (*Reference, Path, error) {
+	path, err := stepsToPath(steps)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ref, err := Find(doc, path...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ref, path, nil
+}
+
+// FindPartial locates a reference like FindWithPath. When the lookup fails, it
+// also returns a reference to the deepest node that could be resolved and the
+// unresolved remainder of the path, starting with the component that failed,
+// alongside the error. If the first component fails, the reference is to doc
+// itself. On success, the remainder is empty.
+//
+// For example, with doc {"a": {"b": {}}}, FindPartial(doc, "a", "b", "c", "d")
+// returns a reference to /a/b, the remainder Path{"c", "d"} and ErrKeyNotFound.
+func FindPartial(doc any, steps ...any) (*Reference, Path, error) {
+	path, err := stepsToPath(steps)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ref, err := Find(doc, path...)
+	if err == nil {
+		return ref, nil, nil
+	}
+
+	// Resolve one component at a time to locate the failing one
+	partial := &Reference{Val: doc, root: doc}
+	for i, key := range path {
+		next, stepErr := Find(partial.Val, key)
+		if stepErr != nil {
+			return partial, path[i:], err
+		}
+		next.path, next.root = path[:i+1], doc
+		partial = next
+	}
+	return partial, nil, err
+}
+
+// FindOptional locates a reference like Find, but returns a nil reference and no error
+// when the final path component does not exist: a missing map key or struct field,
+// or an array index past the end. Other failures, such as a missing intermediate
+// value or an invalid index, are still returned as errors.
+func FindOptional(doc any, path ...string) (*Reference, error) {
+	ref, err := Find(doc, path...)
+	if err == nil {
+		return ref, nil
+	}
+	if !errors.Is(err, ErrKeyNotFound) && !errors.Is(err, ErrFieldNotFound) && !errors.Is(err, ErrIndexOutOfBounds) {
+		return nil, err
+	}
+	// Only the final component may be missing
+	if _, parentErr := Find(doc, path[:len(path)-1]...); parentErr != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
 // GetByPointer retrieves a value from document using JSON Pointer string.
 // Returns errors for invalid operations.
 func GetByPointer(doc any, pointer string) (any, error) {
 	path := Parse(pointer)
+	if root, ok := asRoot(doc); ok && len(path) > 0 {
+		return getFromRoot(root, path)
+	}
 	return get(doc, path)
 }
 
+// GetAny retrieves a value from document using a JSON Pointer string, Path or []string.
+// The pointer is converted with ToPath; any other pointer type returns ErrPointerInvalid.
+func GetAny(doc, pointer any) (any, error) {
+	switch pointer.(type) {
+	case string, Path, []string:
+		return Get(doc, ToPath(pointer)...)
+	default:
+		return nil, ErrPointerInvalid
+	}
+}
+
+// GetFirst tries each JSON Pointer string in order and returns the value of the first
+// one that resolves, together with that pointer. This suits documents whose layout has
+// evolved, where a value may live at a new or a legacy location. If none resolve,
+// GetFirst returns ErrNotFound.
+func GetFirst(doc any, pointers ...string) (any, string, error) {
+	for _, pointer := range pointers {
+		if val, err := GetByPointer(doc, pointer); err == nil {
+			return val, pointer, nil
+		}
+	}
+	return nil, "", ErrNotFound
+}
+
 // FindByPointer locates a reference in document using JSON Pointer string.
 func FindByPointer(doc any, pointer string) (*Reference, error) {
+	if root, ok := asRoot(doc); ok && pointer != "" {
+		return findFromRoot(root, parseJsonPointer(pointer))
+	}
 	return findByPointer(pointer, doc)
 }
 
+// FindByPointerInto locates a reference like FindByPointer but stores it in out
+// instead of allocating a new *Reference, so a single Reference can be reused
+// across calls in tight loops. On error, out is left unchanged.
+func FindByPointerInto(doc any, pointer string, out *Reference) error {
+	val, obj, key, err := ResolveRefByPointer(doc, pointer)
+	if err != nil {
+		return err
+	}
+	*out = Reference{Val: val, Obj: obj, Key: key, pointer: pointer, root: doc}
+	return nil
+}
+
+// ResolveByPointer retrieves a value from document using JSON Pointer string.
+// It resolves like FindByPointer but returns only the value, avoiding the
+// *Reference allocation on hot read paths.
+func ResolveByPointer(doc any, pointer string) (any, error) {
+	val, _, _, err := ResolveRefByPointer(doc, pointer)
+	return val, err
+}
+
+// ResolveRefByPointer locates a reference like FindByPointer but returns its
+// value, container and key as separate results instead of a *Reference.
+func ResolveRefByPointer(doc any, pointer string) (val, obj any, key string, err error) {
+	if root, ok := asRoot(doc); ok && pointer != "" {
+		ref, err := findFromRoot(root, parseJsonPointer(pointer))
+		if err != nil {
+			return nil, nil, "", err
+		}
+		return ref.Val, ref.Obj, ref.Key, nil
+	}
+	return resolveByPointer(pointer, doc)
+}
+
+// IsAddressable reports whether pointer can be the target of a write such as an
+// RFC 6902 "add": either a value exists there, or it denotes the append position
+// of an existing array ("-" or an index equal to the array's length).
+func IsAddressable(doc any, pointer string) bool {
+	path := parseJsonPointer(pointer)
+	if _, err := find(doc, path); err == nil {
+		return true
+	}
+	if len(path) == 0 {
+		return false
+	}
+
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return false
+	}
+
+	// Dereference pointers to arrays so their length can be checked
+	objVal := reflect.ValueOf(parent)
+	for objVal.Kind() == reflect.Ptr && !objVal.IsNil() {
+		objVal = objVal.Elem()
+	}
+	if !objVal.IsValid() {
+		return false
+	}
+	ref := &Reference{Obj: objVal.Interface(), Key: path[len(path)-1]}
+	return ref.IsArrayEnd()
+}
+
 // Parse parses a JSON Pointer string to a path array.
 func Parse(pointer string) Path {
 	return parseJsonPointer(pointer)
 }
 
+// ParseStrict parses a JSON Pointer string to a path array, validating it first.
+// Unlike Parse, it returns ErrPointerInvalid for pointers without a leading slash
+// or with invalid escape sequences, and ErrPointerTooLong for overlong pointers.
+func ParseStrict(pointer string) (Path, error) {
+	if err := validatePointerString(pointer); err != nil {
+		return nil, err
+	}
+	return parseJsonPointer(pointer), nil
+}
+
 // Format formats string path components into a JSON Pointer string.
 func Format(path ...string) string {
-	return formatJsonPointer(Path(path))
+	return canonicalPointer(Path(path))
+}
+
+// ParseWithSeparator parses a pointer that uses sep in place of '/', such as
+// "|a|b~1c" with sep '|', which yields Path{"a", "b|c"}. Like Parse, the empty
+// string is the root and the pointer is expected to start with sep; "~0" and
+// "~1" unescape to '~' and sep. With sep '/' it is equivalent to Parse.
+// It panics if sep is '~', '0' or '1', which would make escapes ambiguous.
+func ParseWithSeparator(pointer string, sep byte) Path {
+	checkSeparator(sep)
+	return parseWithSeparator(pointer, sep)
+}
+
+// FormatWithSeparator formats path as a pointer using sep in place of '/',
+// escaping '~' as "~0" and sep as "~1". It is the inverse of ParseWithSeparator;
+// with sep '/' it is equivalent to Format.
+// It panics if sep is '~', '0' or '1', which would make escapes ambiguous.
+func FormatWithSeparator(path Path, sep byte) string {
+	checkSeparator(sep)
+	return formatWithSeparator(path, sep)
+}
+
+// checkSeparator panics if sep cannot separate pointer components because it
+// is part of the "~0" and "~1" escape sequences.
+func checkSeparator(sep byte) {
+	if sep == '~' || sep == '0' || sep == '1' {
+		panic(fmt.Sprintf("jsonpointer: invalid separator %q", sep))
+	}
+}
+
+// Build formats path steps into an escaped JSON Pointer string. Unlike Format, it
+// accepts integer steps, normalized like NormalizePath, and returns an error for
+// unsupported step types instead of producing a malformed pointer.
+// For example, Build("a/b", 0, "c~d") returns "/a~1b/0/c~0d".
+func Build(steps ...any) (string, error) {
+	path, err := stepsToPath(steps)
+	if err != nil {
+		return "", err
+	}
+	return canonicalPointer(path), nil
+}
+
+// AppendPointer escapes segment and appends it to pointer as a new last component,
+// without parsing pointer. For example, AppendPointer("/a", "b/c") returns "/a/b~1c".
+func AppendPointer(pointer, segment string) string {
+	return childPointer(pointer, segment)
+}
+
+// PopPointer splits pointer into the pointer of its parent and its unescaped last
+// component, without parsing the other components. For example, PopPointer("/a/b~1c")
+// returns "/a", "b/c" and true. It returns false for the root pointer "", which has
+// no parent, and for strings that do not start with a slash.
+func PopPointer(pointer string) (parent, last string, ok bool) {
+	if pointer == "" || pointer[0] != '/' {
+		return "", "", false
+	}
+	i := strings.LastIndexByte(pointer, '/')
+	return pointer[:i], unescapeComponent(pointer[i+1:]), true
+}
+
+// Join concatenates paths into a new Path that does not share any backing array
+// with them.
+func Join(paths ...Path) Path {
+	n := 0
+	for _, path := range paths {
+		n += len(path)
+	}
+	joined := make(Path, 0, n)
+	for _, path := range paths {
+		joined = append(joined, path...)
+	}
+	return joined
+}
+
+// JoinPointers concatenates JSON Pointer strings, such as a base pointer and a
+// pointer relative to it, preserving their escapes. For example,
+// JoinPointers("/a", "/b~1c") returns "/a/b~1c". Root pointers ("") contribute
+// nothing. It returns an error naming the first invalid pointer and wrapping its
+// validation error.
+func JoinPointers(pointers ...string) (string, error) {
+	var b strings.Builder
+	for _, pointer := range pointers {
+		if err := validatePointerString(pointer); err != nil {
+			return "", fmt.Errorf("join pointer %q: %w", pointer, err)
+		}
+		b.WriteString(pointer)
+	}
+	return b.String(), nil
 }
 
 // Escape escapes special characters in a path component.
@@ -89,3 +367,57 @@ func Validate(pointer any) error {
 func ValidatePath(path any) error {
 	return validatePath(path)
 }
+
+// ValidatePathStrict validates a path of mixed steps, as accepted by FindWithPath
+// and NormalizePath. Unlike ValidatePath, it accepts integer steps, but rejects
+// negative integers and non-integral floats, which can never address an array
+// element, returning an error wrapping ErrInvalidPathStep that names the index
+// of the offending step.
+func ValidatePathStrict(path any) error {
+	return validatePathStrict(path)
+}
+
+// IsValidPointer reports whether pointer is a valid JSON Pointer string,
+// i.e. whether Validate returns no error for it.
+func IsValidPointer(pointer string) bool {
+	return validateJsonPointer(pointer) == nil
+}
+
+// IsValidPath reports whether path is a valid path array,
+// i.e. whether ValidatePath returns no error for it.
+func IsValidPath(path any) bool {
+	return validatePath(path) == nil
+}
+
+// GetRunes retrieves a string or []byte value using JSON Pointer string and returns it as runes.
+// The value is decoded as UTF-8, so multi-byte characters map to a single rune.
+// Returns ErrNotText if the target is neither a string nor a byte slice.
+func GetRunes(doc any, pointer string) ([]rune, error) {
+	val, err := GetByPointer(doc, pointer)
+	if err != nil {
+		return nil, err
+	}
+	text, ok := textValue(val)
+	if !ok {
+		return nil, ErrNotText
+	}
+	return []rune(text), nil
+}
+
+// GetBytes retrieves a string or []byte value using JSON Pointer string and returns it as bytes.
+// Byte slices are returned as-is without copying.
+// Returns ErrNotText if the target is neither a string nor a byte slice.
+func GetBytes(doc any, pointer string) ([]byte, error) {
+	val, err := GetByPointer(doc, pointer)
+	if err != nil {
+		return nil, err
+	}
+	if b, ok := val.([]byte); ok {
+		return b, nil
+	}
+	text, ok := textValue(val)
+	if !ok {
+		return nil, ErrNotText
+	}
+	return []byte(text), nil
+}
@@ -0,0 +1,599 @@
+package jsonpointer
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetRunes tests rune retrieval of text values.
+func TestGetRunes(t *testing.T) {
+	doc := map[string]any{
+		"ascii":   "hello",
+		"unicode": "héllo, 世界",
+		"bytes":   []byte("日本"),
+		"number":  42,
+	}
+
+	t.Run("ascii string", func(t *testing.T) {
+		runes, err := GetRunes(doc, "/ascii")
+		assert.NoError(t, err)
+		assert.Equal(t, []rune("hello"), runes)
+	})
+
+	t.Run("multi-byte UTF-8 string", func(t *testing.T) {
+		runes, err := GetRunes(doc, "/unicode")
+		assert.NoError(t, err)
+		assert.Len(t, runes, 9)
+		assert.Equal(t, 'é', runes[1])
+		assert.Equal(t, '世', runes[7])
+	})
+
+	t.Run("byte slice decoded as UTF-8", func(t *testing.T) {
+		runes, err := GetRunes(doc, "/bytes")
+		assert.NoError(t, err)
+		assert.Equal(t, []rune{'日', '本'}, runes)
+	})
+
+	t.Run("non-text value returns error", func(t *testing.T) {
+		_, err := GetRunes(doc, "/number")
+		assert.Equal(t, ErrNotText, err)
+	})
+
+	t.Run("missing value returns lookup error", func(t *testing.T) {
+		_, err := GetRunes(doc, "/missing")
+		assert.Equal(t, ErrKeyNotFound, err)
+	})
+}
+
+// TestGetBytes tests byte retrieval of text values.
+func TestGetBytes(t *testing.T) {
+	raw := json.RawMessage(`{"a":1}`)
+	doc := map[string]any{
+		"unicode": "世界",
+		"bytes":   []byte{0x01, 0x02},
+		"raw":     raw,
+		"flag":    true,
+	}
+
+	t.Run("string returns UTF-8 bytes", func(t *testing.T) {
+		b, err := GetBytes(doc, "/unicode")
+		assert.NoError(t, err)
+		assert.Len(t, b, 6)
+		assert.Equal(t, []byte("世界"), b)
+	})
+
+	t.Run("byte slice returned as-is", func(t *testing.T) {
+		b, err := GetBytes(doc, "/bytes")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0x01, 0x02}, b)
+	})
+
+	t.Run("named byte slice type", func(t *testing.T) {
+		b, err := GetBytes(doc, "/raw")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(raw), b)
+	})
+
+	t.Run("non-text value returns error", func(t *testing.T) {
+		_, err := GetBytes(doc, "/flag")
+		assert.Equal(t, ErrNotText, err)
+	})
+}
+
+// TestGetAny tests value retrieval with polymorphic pointer input.
+func TestGetAny(t *testing.T) {
+	doc := map[string]any{
+		"users": []any{
+			map[string]any{"name": "Alice", "a/b": 1},
+		},
+	}
+
+	t.Run("all pointer forms resolve to the same value", func(t *testing.T) {
+		for _, pointer := range []any{
+			"/users/0/name",
+			Path{"users", "0", "name"},
+			[]string{"users", "0", "name"},
+		} {
+			val, err := GetAny(doc, pointer)
+			assert.NoError(t, err)
+			assert.Equal(t, "Alice", val)
+		}
+	})
+
+	t.Run("string pointers are unescaped", func(t *testing.T) {
+		val, err := GetAny(doc, "/users/0/a~1b")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+
+		val, err = GetAny(doc, Path{"users", "0", "a/b"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+	})
+
+	t.Run("empty pointer returns document", func(t *testing.T) {
+		val, err := GetAny(doc, "")
+		assert.NoError(t, err)
+		assert.Equal(t, doc, val)
+	})
+
+	t.Run("unsupported pointer type", func(t *testing.T) {
+		_, err := GetAny(doc, 42)
+		assert.Equal(t, ErrPointerInvalid, err)
+	})
+}
+
+// TestReferencePresence tests distinguishing JSON null from absent values.
+func TestReferencePresence(t *testing.T) {
+	doc := map[string]any{
+		"null":    nil,
+		"value":   "x",
+		"nilPtr":  (*User)(nil),
+		"nilMap":  map[string]any(nil),
+		"nested":  map[string]any{},
+		"items":   []any{nil},
+		"zeroInt": 0,
+	}
+
+	tests := []struct {
+		name    string
+		path    []string
+		present bool
+		null    bool
+	}{
+		{"explicit nil value", []string{"null"}, true, true},
+		{"non-null value", []string{"value"}, true, false},
+		{"zero value is not null", []string{"zeroInt"}, true, false},
+		{"nil pointer is null", []string{"nilPtr"}, true, true},
+		{"nil map is null", []string{"nilMap"}, true, true},
+		{"null array element", []string{"items", "0"}, true, true},
+		{"missing key", []string{"missing"}, false, false},
+		{"missing nested key", []string{"nested", "missing"}, false, false},
+		{"index past end", []string{"items", "1"}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := FindOptional(doc, tt.path...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.present, ref.Present())
+			assert.Equal(t, tt.null, ref.IsNull())
+		})
+	}
+
+	t.Run("missing intermediate value is an error", func(t *testing.T) {
+		ref, err := FindOptional(doc, "missing", "child")
+		assert.Equal(t, ErrKeyNotFound, err)
+		assert.Nil(t, ref)
+	})
+
+	t.Run("invalid index is an error", func(t *testing.T) {
+		_, err := FindOptional(doc, "items", "x")
+		assert.Equal(t, ErrInvalidIndex, err)
+	})
+}
+
+// TestParseStrict tests validating parsing of JSON Pointer strings.
+func TestParseStrict(t *testing.T) {
+	t.Run("valid pointers parse like Parse", func(t *testing.T) {
+		for _, pointer := range []string{"", "/", "/foo/0", "/a~1b/c~0d", "/foo///"} {
+			path, err := ParseStrict(pointer)
+			assert.NoError(t, err, pointer)
+			assert.Equal(t, Parse(pointer), path, pointer)
+		}
+	})
+
+	t.Run("malformed pointers return errors", func(t *testing.T) {
+		for _, pointer := range []string{"foo/bar", "foo", "/foo~", "/foo~2bar", "#/foo"} {
+			path, err := ParseStrict(pointer)
+			assert.Equal(t, ErrPointerInvalid, err, pointer)
+			assert.Nil(t, path, pointer)
+		}
+	})
+
+	t.Run("overlong pointer", func(t *testing.T) {
+		_, err := ParseStrict("/" + strings.Repeat("a", 1024))
+		assert.Equal(t, ErrPointerTooLong, err)
+	})
+}
+
+// TestReferenceIsArrayEnd tests detecting the append position of an array.
+func TestReferenceIsArrayEnd(t *testing.T) {
+	items := []any{"a", "b"}
+
+	tests := []struct {
+		name     string
+		ref      *Reference
+		expected bool
+	}{
+		{"dash marker", &Reference{Obj: items, Key: "-"}, true},
+		{"one past end index", &Reference{Obj: items, Key: "2"}, true},
+		{"typed slice", &Reference{Obj: []string{"a"}, Key: "1"}, true},
+		{"fixed size array", &Reference{Obj: [2]int{1, 2}, Key: "2"}, true},
+		{"existing element", &Reference{Val: "b", Obj: items, Key: "1"}, false},
+		{"beyond end index", &Reference{Obj: items, Key: "3"}, false},
+		{"leading zero index", &Reference{Obj: items, Key: "02"}, false},
+		{"object reference", &Reference{Obj: map[string]any{}, Key: "-"}, false},
+		{"root reference", &Reference{Val: items}, false},
+		{"nil reference", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.ref.IsArrayEnd())
+		})
+	}
+
+	t.Run("agrees with the generic helper", func(t *testing.T) {
+		ref := &Reference{Obj: items, Key: "2"}
+		assert.Equal(t, IsArrayEnd(ArrayReference[any]{Obj: items, Key: 2}), ref.IsArrayEnd())
+	})
+}
+
+func TestReferenceSameLocation(t *testing.T) {
+	doc := map[string]any{
+		"users": []any{
+			map[string]any{"name": "Alice"},
+			map[string]any{"name": "Alice"},
+		},
+	}
+
+	a, err := FindByPointer(doc, "/users/0/name")
+	assert.NoError(t, err)
+	b, err := Find(doc, "users", "0", "name")
+	assert.NoError(t, err)
+	other, err := FindByPointer(doc, "/users/1/name")
+	assert.NoError(t, err)
+
+	assert.True(t, a.SameLocation(b))
+	assert.Equal(t, a.LocationKey(), b.LocationKey())
+	assert.NotEmpty(t, a.LocationKey())
+
+	// Equal values in distinct containers are different locations
+	assert.False(t, a.SameLocation(other))
+	assert.NotEqual(t, a.LocationKey(), other.LocationKey())
+
+	// Different keys in the same container are different locations
+	first, err := FindByPointer(doc, "/users/0")
+	assert.NoError(t, err)
+	second, err := FindByPointer(doc, "/users/1")
+	assert.NoError(t, err)
+	assert.False(t, first.SameLocation(second))
+
+	t.Run("deduplicate wildcard matches", func(t *testing.T) {
+		refs, err := GetAll(doc, "/**/name")
+		assert.NoError(t, err)
+		seen := make(map[string]bool)
+		for _, ref := range refs {
+			seen[ref.LocationKey()] = true
+		}
+		assert.Len(t, seen, 2)
+		assert.True(t, seen[a.LocationKey()])
+	})
+
+	t.Run("values without identity", func(t *testing.T) {
+		ref, err := Find(Profile{Location: "Tokyo"}, "location")
+		assert.NoError(t, err)
+		assert.False(t, ref.SameLocation(ref))
+		assert.Equal(t, "/location", ref.LocationKey())
+
+		var nilRef *Reference
+		assert.False(t, nilRef.SameLocation(a))
+		assert.False(t, a.SameLocation(nil))
+	})
+}
+
+// TestReferenceLocationKey tests that location keys are the canonical pointers of
+// the locations, whichever lookup produced the reference.
+func TestReferenceLocationKey(t *testing.T) {
+	doc := map[string]any{
+		"a/b":   map[string]any{"c~d": 1},
+		"empty": []any{},
+		"items": []any{[]any{}, []any{}},
+	}
+	expected := "/a~1b/c~0d"
+
+	ref, err := Find(doc, "a/b", "c~d")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, ref.LocationKey())
+
+	ref, err = FindByPointer(doc, expected)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, ref.LocationKey())
+
+	var out Reference
+	assert.NoError(t, FindByPointerInto(doc, expected, &out))
+	assert.Equal(t, expected, out.LocationKey())
+
+	ref, err = New(Options{}).Find(doc, "a/b", "c~d")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, ref.LocationKey())
+
+	ref, err = FindOne(doc, "/*/c~0d")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, ref.LocationKey())
+
+	partial, _, err := FindPartial(doc, "a/b", "c~d", "x")
+	assert.Error(t, err)
+	assert.Equal(t, expected, partial.LocationKey())
+
+	// Empty slices may share storage but are distinct locations
+	first, err := Find(doc, "items", "0")
+	assert.NoError(t, err)
+	second, err := Find(doc, "items", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "/items/0", first.LocationKey())
+	assert.Equal(t, "/items/1", second.LocationKey())
+
+	root, err := Find(doc)
+	assert.NoError(t, err)
+	assert.Empty(t, root.LocationKey())
+	assert.Empty(t, (&Reference{Val: 1}).LocationKey())
+}
+
+// TestReferenceNavigation tests moving from a found reference to its ancestors and children.
+func TestReferenceNavigation(t *testing.T) {
+	doc := map[string]any{
+		"users": []any{
+			map[string]any{
+				"name":    "Alice",
+				"address": map[string]any{"city": "Tokyo"},
+				"tags":    []any{"admin"},
+			},
+		},
+	}
+
+	city, err := FindByPointer(doc, "/users/0/address/city")
+	assert.NoError(t, err)
+
+	t.Run("up two levels then into a sibling", func(t *testing.T) {
+		user, err := city.Up(2)
+		assert.NoError(t, err)
+		assert.Equal(t, "/users/0", user.LocationKey())
+
+		tags, err := user.Child(doc, "tags")
+		assert.NoError(t, err)
+		assert.Equal(t, []any{"admin"}, tags.Val)
+
+		tag, err := tags.Child(doc, "0")
+		assert.NoError(t, err)
+		assert.Equal(t, "admin", tag.Val)
+		assert.Equal(t, "/users/0/tags/0", tag.LocationKey())
+	})
+
+	t.Run("up to the root", func(t *testing.T) {
+		root, err := city.Up(4)
+		assert.NoError(t, err)
+		assert.Equal(t, doc, root.Val)
+
+		users, err := root.Child(doc, "users")
+		assert.NoError(t, err)
+		assert.Equal(t, doc["users"], users.Val)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := city.Up(5)
+		assert.Equal(t, ErrNoParent, err)
+
+		_, err = city.Up(-1)
+		assert.Equal(t, ErrInvalidPath, err)
+
+		_, err = city.Child(doc, "x")
+		assert.Equal(t, ErrNotFound, err)
+
+		_, err = (&Reference{Obj: doc, Key: "users"}).Up(1)
+		assert.Equal(t, ErrNoLocation, err)
+	})
+
+	t.Run("navigation uses the producing resolver", func(t *testing.T) {
+		cfg := &yamlConfig{Host: "localhost", Port: 8080}
+		r := New(Options{TagName: "yaml", CaseInsensitive: true})
+		wrapped := map[string]any{"Servers": []any{cfg}}
+
+		port, err := r.FindByPointer(wrapped, "/servers/0/PORT")
+		assert.NoError(t, err)
+		server, err := port.Up(1)
+		assert.NoError(t, err)
+		assert.Same(t, cfg, server.Val)
+
+		host, err := server.Child(wrapped, "HOST")
+		assert.NoError(t, err)
+		assert.Equal(t, "localhost", host.Val)
+
+		// The package-level functions know neither option
+		_, err = Find(wrapped, "servers", "0")
+		assert.Equal(t, ErrKeyNotFound, err)
+	})
+
+	t.Run("Root documents", func(t *testing.T) {
+		root := configRoot{sections: map[string]map[string]any{"server": {"port": 8080}}}
+		port, err := Find(root, "server", "port")
+		assert.NoError(t, err)
+
+		server, err := port.Up(1)
+		assert.NoError(t, err)
+		assert.Equal(t, root.sections["server"], server.Val)
+
+		top, err := port.Up(2)
+		assert.NoError(t, err)
+		assert.Equal(t, root, top.Val)
+	})
+}
+
+// TestIsAddressable tests which locations can be the target of a write.
+func TestGetFirst(t *testing.T) {
+	doc := map[string]any{
+		"legacy":  map[string]any{"port": 80},
+		"server":  map[string]any{"port": 8080},
+		"nothing": nil,
+	}
+
+	val, pointer, err := GetFirst(doc, "/config/port", "/server/port", "/legacy/port")
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, val)
+	assert.Equal(t, "/server/port", pointer)
+
+	val, pointer, err = GetFirst(doc, "/config/port", "/legacy/port")
+	assert.NoError(t, err)
+	assert.Equal(t, 80, val)
+	assert.Equal(t, "/legacy/port", pointer)
+
+	// A null value still resolves
+	val, pointer, err = GetFirst(doc, "/nothing", "/server/port")
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+	assert.Equal(t, "/nothing", pointer)
+
+	_, pointer, err = GetFirst(doc, "/config/port", "/server/host")
+	assert.Equal(t, ErrNotFound, err)
+	assert.Empty(t, pointer)
+
+	_, _, err = GetFirst(doc)
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestIsAddressable(t *testing.T) {
+	arr := []any{1, 2, 3}
+	doc := map[string]any{
+		"arr":   arr,
+		"ptr":   &arr,
+		"typed": []string{"a"},
+		"obj":   map[string]any{"k": "v"},
+		"null":  nil,
+	}
+
+	tests := []struct {
+		pointer  string
+		expected bool
+	}{
+		{"", true},
+		{"/arr", true},
+		{"/arr/0", true},
+		{"/arr/3", true},
+		{"/arr/-", true},
+		{"/ptr/3", true},
+		{"/typed/1", true},
+		{"/typed/-", true},
+		{"/null", true},
+		{"/arr/4", false},
+		{"/arr/03", false},
+		{"/arr/-/x", false},
+		{"/obj/-", false},
+		{"/obj/missing", false},
+		{"/missing/0", false},
+		{"/null/-", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pointer, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsAddressable(doc, tt.pointer))
+		})
+	}
+}
+
+// TestFindWithPath tests finding references with normalized paths.
+func TestFindWithPath(t *testing.T) {
+	doc := map[string]any{
+		"users": []any{
+			map[string]any{"name": "Alice", "tags": []any{"a", "b"}},
+		},
+	}
+
+	t.Run("numeric and string index steps normalize alike", func(t *testing.T) {
+		expected := Path{"users", "0", "tags", "1"}
+		for _, steps := range [][]any{
+			{"users", "0", "tags", "1"},
+			{"users", 0, "tags", 1},
+			{"users", int64(0), "tags", uint(1)},
+			{"users", 0.0, "tags", 1.0},
+		} {
+			ref, path, err := FindWithPath(doc, steps...)
+			assert.NoError(t, err, steps)
+			assert.Equal(t, "b", ref.Val, steps)
+			assert.Equal(t, expected, path, steps)
+		}
+	})
+
+	t.Run("root", func(t *testing.T) {
+		ref, path, err := FindWithPath(doc)
+		assert.NoError(t, err)
+		assert.Equal(t, doc, ref.Val)
+		assert.Empty(t, path)
+	})
+
+	t.Run("invalid steps", func(t *testing.T) {
+		_, _, err := FindWithPath(doc, "users", -1)
+		assert.Equal(t, ErrInvalidIndex, err)
+
+		_, _, err = FindWithPath(doc, "users", 1.5)
+		assert.Equal(t, ErrInvalidPathStep, err)
+
+		_, _, err = FindWithPath(doc, "users", -1.0)
+		assert.Equal(t, ErrInvalidIndex, err)
+
+		_, _, err = FindWithPath(doc, "users", math.Inf(1))
+		assert.Equal(t, ErrInvalidPathStep, err)
+
+		_, _, err = FindWithPath(doc, "users", 3)
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+	})
+}
+
+func TestFindPartial(t *testing.T) {
+	doc := map[string]any{
+		"a": map[string]any{
+			"b": map[string]any{"x": 1},
+		},
+		"list": []any{map[string]any{"name": "Alice"}},
+	}
+
+	t.Run("fails at the third of four segments", func(t *testing.T) {
+		ref, rest, err := FindPartial(doc, "a", "b", "c", "d")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+		assert.Equal(t, doc["a"].(map[string]any)["b"], ref.Val)
+		assert.Equal(t, doc["a"], ref.Obj)
+		assert.Equal(t, "b", ref.Key)
+		assert.Equal(t, Path{"c", "d"}, rest)
+	})
+
+	t.Run("fails at the first segment", func(t *testing.T) {
+		ref, rest, err := FindPartial(doc, "missing", "x")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+		assert.Equal(t, &Reference{Val: doc, root: doc}, ref)
+		assert.Equal(t, Path{"missing", "x"}, rest)
+	})
+
+	t.Run("integer steps and array errors", func(t *testing.T) {
+		ref, rest, err := FindPartial(doc, "list", 3, "name")
+		assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+		assert.Equal(t, doc["list"], ref.Val)
+		assert.Equal(t, Path{"3", "name"}, rest)
+
+		ref, rest, err = FindPartial(doc, "list", "-", "name")
+		assert.ErrorIs(t, err, ErrArrayEndNotFinal)
+		assert.Equal(t, doc["list"], ref.Val)
+		assert.Equal(t, Path{"-", "name"}, rest)
+	})
+
+	t.Run("traversing into a scalar", func(t *testing.T) {
+		ref, rest, err := FindPartial(doc, "a", "b", "x", "y")
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Equal(t, 1, ref.Val)
+		assert.Equal(t, Path{"y"}, rest)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		ref, rest, err := FindPartial(doc, "list", 0, "name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", ref.Val)
+		assert.Empty(t, rest)
+	})
+
+	t.Run("invalid step", func(t *testing.T) {
+		_, _, err := FindPartial(doc, "a", true)
+		assert.ErrorIs(t, err, ErrInvalidPathStep)
+	})
+}
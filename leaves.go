@@ -0,0 +1,62 @@
+package jsonpointer
+
+// Leaf is a value without children together with the JSON Pointer locating it.
+type Leaf struct {
+	Pointer string
+	Value   any
+}
+
+// GetAllLeaves returns every leaf of doc with its pointer, in canonical pointer order:
+// map keys sorted, array elements by index and struct fields in declaration order.
+// Scalars, nil values and empty containers are leaves; a scalar doc yields a
+// single leaf with the root pointer "".
+func GetAllLeaves(doc any) []Leaf {
+	var leaves []Leaf
+	collectLeaves(doc, "", &leaves)
+	return leaves
+}
+
+// collectLeaves appends the leaves below val, whose pointer is prefix, to leaves.
+func collectLeaves(val any, prefix string, leaves *[]Leaf) {
+	hasChildren := false
+	forEachChild(val, func(key string, child any) bool {
+		hasChildren = true
+		collectLeaves(child, childPointer(prefix, key), leaves)
+		return true
+	})
+	if !hasChildren {
+		*leaves = append(*leaves, Leaf{Pointer: prefix, Value: val})
+	}
+}
+
+// Any reports whether predicate returns true for any leaf of doc, as returned by
+// GetAllLeaves. Leaves are visited in the same order, and the walk stops at the
+// first leaf for which predicate returns true.
+func Any(doc any, predicate func(pointer string, value any) bool) bool {
+	return anyLeaf(doc, "", predicate)
+}
+
+// All reports whether predicate returns true for every leaf of doc, as returned by
+// GetAllLeaves. Leaves are visited in the same order, and the walk stops at the
+// first leaf for which predicate returns false.
+func All(doc any, predicate func(pointer string, value any) bool) bool {
+	return !anyLeaf(doc, "", func(pointer string, value any) bool {
+		return !predicate(pointer, value)
+	})
+}
+
+// anyLeaf reports whether predicate holds for any leaf below val, whose pointer
+// is prefix, stopping at the first match.
+func anyLeaf(val any, prefix string, predicate func(pointer string, value any) bool) bool {
+	found := false
+	hasChildren := false
+	forEachChild(val, func(key string, child any) bool {
+		hasChildren = true
+		found = anyLeaf(child, childPointer(prefix, key), predicate)
+		return !found
+	})
+	if !hasChildren {
+		return predicate(prefix, val)
+	}
+	return found
+}
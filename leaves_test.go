@@ -0,0 +1,105 @@
+package jsonpointer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetAllLeaves tests collecting leaves in canonical pointer order.
+func TestGetAllLeaves(t *testing.T) {
+	t.Run("nested document", func(t *testing.T) {
+		doc := map[string]any{
+			"b":     []any{1, map[string]any{"y": true, "x": nil}},
+			"a":     "first",
+			"a/b":   2.5,
+			"empty": map[string]any{},
+			"user":  User{Name: "Alice", Age: 30, Email: "a@example.com"},
+		}
+
+		expected := []Leaf{
+			{"/a", "first"},
+			{"/a~1b", 2.5},
+			{"/b/0", 1},
+			{"/b/1/x", nil},
+			{"/b/1/y", true},
+			{"/empty", map[string]any{}},
+			{"/user/name", "Alice"},
+			{"/user/age", 30},
+			{"/user/Email", "a@example.com"},
+		}
+		leaves := GetAllLeaves(doc)
+		assert.Equal(t, expected, leaves)
+
+		// Every pointer resolves back to its value
+		for _, leaf := range leaves {
+			val, err := GetByPointer(doc, leaf.Pointer)
+			assert.NoError(t, err, leaf.Pointer)
+			assert.Equal(t, leaf.Value, val, leaf.Pointer)
+		}
+	})
+
+	t.Run("array indices in numeric order", func(t *testing.T) {
+		items := make([]any, 12)
+		for i := range items {
+			items[i] = i
+		}
+		leaves := GetAllLeaves(items)
+		assert.Len(t, leaves, 12)
+		assert.Equal(t, "/2", leaves[2].Pointer)
+		assert.Equal(t, "/11", leaves[11].Pointer)
+	})
+
+	t.Run("scalar document is a single root leaf", func(t *testing.T) {
+		assert.Equal(t, []Leaf{{"", "value"}}, GetAllLeaves("value"))
+		assert.Equal(t, []Leaf{{"", nil}}, GetAllLeaves(nil))
+	})
+}
+
+// TestAnyAll tests short-circuiting predicates over leaves.
+func TestAnyAll(t *testing.T) {
+	doc := map[string]any{
+		"features": []any{
+			map[string]any{"name": "a", "enabled": true},
+			map[string]any{"name": "b", "enabled": true},
+		},
+		"note": nil,
+		"user": User{Name: "Alice"},
+	}
+	isNil := func(_ string, value any) bool { return value == nil }
+	enabled := func(pointer string, value any) bool {
+		return !strings.HasSuffix(pointer, "/enabled") || value == true
+	}
+
+	t.Run("any stops at the first match", func(t *testing.T) {
+		var visited []string
+		found := Any(doc, func(pointer string, value any) bool {
+			visited = append(visited, pointer)
+			return value == "a"
+		})
+		assert.True(t, found)
+		assert.Equal(t, []string{"/features/0/enabled", "/features/0/name"}, visited)
+	})
+
+	t.Run("any", func(t *testing.T) {
+		assert.True(t, Any(doc, isNil))
+		assert.False(t, Any(doc, func(_ string, value any) bool { return value == "missing" }))
+		assert.True(t, Any(42, func(pointer string, value any) bool { return pointer == "" && value == 42 }))
+	})
+
+	t.Run("all", func(t *testing.T) {
+		assert.True(t, All(doc, enabled))
+		assert.False(t, All(doc, func(_ string, value any) bool { return value != nil }))
+
+		calls := 0
+		assert.False(t, All(doc, func(string, any) bool {
+			calls++
+			return false
+		}))
+		assert.Equal(t, 1, calls)
+
+		doc["features"].([]any)[1].(map[string]any)["enabled"] = false
+		assert.False(t, All(doc, enabled))
+	})
+}
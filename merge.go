@@ -0,0 +1,97 @@
+package jsonpointer
+
+import "reflect"
+
+// MergeDiff returns a JSON Merge Patch (RFC 7386) that turns a into b. Members
+// added or changed in b appear with their new values, members removed from b
+// appear as nil (JSON null), and members that are objects in both a and b are
+// diffed recursively. Arrays and scalars are replaced wholesale and compared with
+// reflect.DeepEqual. Maps with string keys and structs with JSON-visible fields
+// count as objects; values that encode as JSON scalars, such as time.Time, byte
+// slices and json.Marshaler implementations, are scalars.
+//
+// Merge patches cannot express null values: a member that is null in b is treated
+// as removed. If b is not an object, no merge patch other than b itself can
+// produce it, and MergeDiff returns nil; a non-object a is diffed as if empty.
+func MergeDiff(a, b any) map[string]any {
+	bMembers, ok := objectMembers(b)
+	if !ok {
+		return nil
+	}
+	aMembers, _ := objectMembers(a)
+
+	patch := make(map[string]any)
+	for key := range aMembers {
+		if bVal, exists := bMembers[key]; !exists || isNull(bVal) {
+			patch[key] = nil
+		}
+	}
+	for key, bVal := range bMembers {
+		if isNull(bVal) {
+			continue
+		}
+		aVal, exists := aMembers[key]
+		if exists && isJSONObject(aVal) && isJSONObject(bVal) {
+			if sub := MergeDiff(aVal, bVal); len(sub) > 0 {
+				patch[key] = sub
+			}
+			continue
+		}
+		if !exists || !reflect.DeepEqual(aVal, bVal) {
+			patch[key] = bVal
+		}
+	}
+	return patch
+}
+
+// ApplyMergePatch applies a JSON Merge Patch (RFC 7386) to doc and returns the
+// result. Patch members that are nil (JSON null) delete the key, object members
+// are merged recursively, and any other value replaces the target member
+// wholesale, including values that encode as JSON scalars such as time.Time.
+// If doc is not an object, the patch is applied to an empty object.
+//
+// Neither doc nor patch is modified: every merged object is rebuilt as a new
+// map[string]any, while untouched members and replacement values are shared
+// with the inputs rather than copied.
+func ApplyMergePatch(doc any, patch map[string]any) any {
+	return mergePatch(doc, patch)
+}
+
+// mergePatch applies the members of patch, which must be an object, to target.
+func mergePatch(target, patch any) map[string]any {
+	result, ok := objectMembers(target)
+	if !ok {
+		result = make(map[string]any)
+	}
+	forEachChild(patch, func(key string, val any) bool {
+		switch {
+		case isNull(val):
+			delete(result, key)
+		case isJSONObject(val):
+			result[key] = mergePatch(result[key], val)
+		default:
+			result[key] = val
+		}
+		return true
+	})
+	return result
+}
+
+// isJSONObject reports whether val encodes as a JSON object.
+func isJSONObject(val any) bool {
+	object, _ := jsonContainerKind(val)
+	return object
+}
+
+// objectMembers returns the members of val by key if it is an object.
+func objectMembers(val any) (map[string]any, bool) {
+	if !isJSONObject(val) {
+		return nil, false
+	}
+	members := make(map[string]any)
+	forEachChild(val, func(key string, child any) bool {
+		members[key] = child
+		return true
+	})
+	return members, true
+}
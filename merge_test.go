@@ -0,0 +1,150 @@
+package jsonpointer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMergeDiff tests generating JSON Merge Patches.
+func TestMergeDiff(t *testing.T) {
+	a := map[string]any{
+		"title":  "Hello",
+		"author": map[string]any{"givenName": "John", "familyName": "Doe"},
+		"tags":   []any{"example", "sample"},
+		"same":   map[string]any{"x": 1},
+		"gone":   true,
+	}
+	b := map[string]any{
+		"title":       "Hello!",
+		"author":      map[string]any{"givenName": "John"},
+		"tags":        []any{"example"},
+		"same":        map[string]any{"x": 1},
+		"phoneNumber": "+01-123-456-7890",
+	}
+
+	assert.Equal(t, map[string]any{
+		"title":       "Hello!",
+		"author":      map[string]any{"familyName": nil},
+		"tags":        []any{"example"},
+		"gone":        nil,
+		"phoneNumber": "+01-123-456-7890",
+	}, MergeDiff(a, b))
+
+	t.Run("identical documents", func(t *testing.T) {
+		assert.Empty(t, MergeDiff(a, a))
+	})
+
+	t.Run("null members are removals", func(t *testing.T) {
+		patch := MergeDiff(map[string]any{"k": 1, "n": nil}, map[string]any{"k": nil})
+		assert.Equal(t, map[string]any{"k": nil, "n": nil}, patch)
+	})
+
+	t.Run("objects replace non-objects wholesale", func(t *testing.T) {
+		patch := MergeDiff(map[string]any{"k": "scalar"}, map[string]any{"k": map[string]any{"x": 1}})
+		assert.Equal(t, map[string]any{"k": map[string]any{"x": 1}}, patch)
+	})
+
+	t.Run("structs are objects", func(t *testing.T) {
+		patch := MergeDiff(User{Name: "Alice", Age: 30}, User{Name: "Bob", Age: 30})
+		assert.Equal(t, map[string]any{"name": "Bob"}, patch)
+	})
+
+	t.Run("struct-valued scalars", func(t *testing.T) {
+		t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		t2 := t1.Add(time.Hour)
+		patch := MergeDiff(map[string]any{"t": t1, "b": []byte("a")}, map[string]any{"t": t2, "b": []byte("b")})
+		assert.Equal(t, map[string]any{"t": t2, "b": []byte("b")}, patch)
+
+		assert.Empty(t, MergeDiff(map[string]any{"t": t1}, map[string]any{"t": t1}))
+	})
+
+	t.Run("non-object target", func(t *testing.T) {
+		assert.Nil(t, MergeDiff(a, []any{1}))
+		assert.Equal(t, map[string]any{"k": 1}, MergeDiff("scalar", map[string]any{"k": 1}))
+	})
+}
+
+// TestApplyMergePatch tests applying JSON Merge Patches.
+func TestApplyMergePatch(t *testing.T) {
+	doc := map[string]any{
+		"title":   "Goodbye!",
+		"author":  map[string]any{"givenName": "John", "familyName": "Doe"},
+		"tags":    []any{"example", "sample"},
+		"content": "This will be unchanged",
+	}
+	patch := map[string]any{
+		"title":       "Hello!",
+		"phoneNumber": "+01-123-456-7890",
+		"author":      map[string]any{"familyName": nil},
+		"tags":        []any{"example"},
+	}
+
+	result := ApplyMergePatch(doc, patch)
+	assert.Equal(t, map[string]any{
+		"title":       "Hello!",
+		"author":      map[string]any{"givenName": "John"},
+		"tags":        []any{"example"},
+		"content":     "This will be unchanged",
+		"phoneNumber": "+01-123-456-7890",
+	}, result)
+
+	// Inputs are not modified
+	assert.Equal(t, "Goodbye!", doc["title"])
+	assert.Equal(t, "Doe", doc["author"].(map[string]any)["familyName"])
+	assert.Nil(t, patch["author"].(map[string]any)["familyName"])
+
+	t.Run("rfc 7386 examples", func(t *testing.T) {
+		tests := []struct {
+			doc      any
+			patch    map[string]any
+			expected any
+		}{
+			{map[string]any{"a": "b"}, map[string]any{"a": "c"}, map[string]any{"a": "c"}},
+			{map[string]any{"a": "b"}, map[string]any{"b": "c"}, map[string]any{"a": "b", "b": "c"}},
+			{map[string]any{"a": "b"}, map[string]any{"a": nil}, map[string]any{}},
+			{map[string]any{"a": "b", "b": "c"}, map[string]any{"a": nil}, map[string]any{"b": "c"}},
+			{map[string]any{"a": []any{"b"}}, map[string]any{"a": "c"}, map[string]any{"a": "c"}},
+			{map[string]any{"a": "c"}, map[string]any{"a": []any{"b"}}, map[string]any{"a": []any{"b"}}},
+			{map[string]any{"a": map[string]any{"b": "c"}}, map[string]any{"a": map[string]any{"b": "d", "c": nil}}, map[string]any{"a": map[string]any{"b": "d"}}},
+			{map[string]any{"a": []any{map[string]any{"b": "c"}}}, map[string]any{"a": []any{1}}, map[string]any{"a": []any{1}}},
+			{[]any{"a", "b"}, map[string]any{"a": "c"}, map[string]any{"a": "c"}},
+			{"string", map[string]any{"a": "b"}, map[string]any{"a": "b"}},
+			{map[string]any{"e": nil}, map[string]any{"a": 1}, map[string]any{"e": nil, "a": 1}},
+			{nil, map[string]any{"a": map[string]any{"bb": map[string]any{"ccc": nil}}}, map[string]any{"a": map[string]any{"bb": map[string]any{}}}},
+		}
+		for _, tt := range tests {
+			assert.Equal(t, tt.expected, ApplyMergePatch(tt.doc, tt.patch), "%v + %v", tt.doc, tt.patch)
+		}
+	})
+
+	t.Run("struct-valued scalars replace wholesale", func(t *testing.T) {
+		t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		t2 := t1.Add(time.Hour)
+		result := ApplyMergePatch(map[string]any{"t": t1}, map[string]any{"t": t2})
+		assert.Equal(t, map[string]any{"t": t2}, result)
+
+		result = ApplyMergePatch(map[string]any{"t": map[string]any{"x": 1}}, map[string]any{"t": &t2})
+		assert.Equal(t, map[string]any{"t": &t2}, result)
+
+		// A scalar target member is replaced by an object patch
+		result = ApplyMergePatch(map[string]any{"t": t1}, map[string]any{"t": map[string]any{"x": 1}})
+		assert.Equal(t, map[string]any{"t": map[string]any{"x": 1}}, result)
+	})
+
+	t.Run("round trips MergeDiff", func(t *testing.T) {
+		a := map[string]any{
+			"server": map[string]any{"host": "localhost", "port": 80, "tls": map[string]any{"cert": "a.pem"}},
+			"users":  []any{"alice"},
+			"debug":  true,
+		}
+		b := map[string]any{
+			"server": map[string]any{"host": "example.com", "port": 80, "tls": map[string]any{"cert": "b.pem", "key": "b.key"}},
+			"users":  []any{"alice", "bob"},
+			"limits": map[string]any{"rps": 100},
+		}
+		assert.Equal(t, b, ApplyMergePatch(a, MergeDiff(a, b)))
+		assert.Equal(t, a, ApplyMergePatch(b, MergeDiff(b, a)))
+	})
+}
@@ -0,0 +1,138 @@
+package jsonpointer
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// sliceAccessor reads and writes elements of a registered slice type without reflection.
+type sliceAccessor struct {
+	// index returns the element at index, or false if index is out of bounds.
+	index func(slice any, index int) (any, bool)
+
+	// set stores value at index of the slice held by slice, in place. It returns
+	// false, without modifying the slice, if value is not exactly of the element
+	// type or index is out of bounds, so the caller can fall back to reflection.
+	set func(slice reflect.Value, index int, value any) bool
+
+	// remove removes the in-bounds element at index of the slice held by slice
+	// in place, like slices.Delete, and returns the shortened slice.
+	remove func(slice reflect.Value, index int) reflect.Value
+}
+
+var (
+	// sliceAccessors holds an immutable map[reflect.Type]sliceAccessor of registered slice types.
+	// It is replaced as a whole on registration so lookups need no locking.
+	sliceAccessors atomic.Pointer[map[reflect.Type]sliceAccessor]
+
+	// sliceAccessorsMu serializes registrations
+	sliceAccessorsMu sync.Mutex
+)
+
+// RegisterSliceType installs a fast accessor for []T so that indexing into it
+// avoids reflection in Get and Find, replacing its elements with values of type T
+// avoids reflection in Set, and removing its elements avoids reflection in Delete.
+// The write fast paths apply when the slice element is the final path component,
+// wherever the slice is nested; writes below an element, such as into a field of
+// a struct element, and appends or insertions still use reflection since they
+// build new values. Slices of types other than the built-in
+// fast paths ([]any, []string, []int, []float64) otherwise fall back to reflection,
+// which dominates the cost in hot loops over slices of structs.
+// Registration is global and safe for concurrent use; registering a type twice has no effect.
+func RegisterSliceType[T any]() {
+	sliceAccessorsMu.Lock()
+	defer sliceAccessorsMu.Unlock()
+
+	sliceType := reflect.TypeFor[[]T]()
+	current := sliceAccessors.Load()
+	if current != nil {
+		if _, ok := (*current)[sliceType]; ok {
+			return
+		}
+	}
+
+	next := make(map[reflect.Type]sliceAccessor)
+	if current != nil {
+		for t, accessor := range *current {
+			next[t] = accessor
+		}
+	}
+	next[sliceType] = sliceAccessor{
+		index: func(slice any, index int) (any, bool) {
+			return indexSlice(slice.([]T), index)
+		},
+		set:    setSliceElem[T],
+		remove: removeSliceElem[T],
+	}
+	sliceAccessors.Store(&next)
+}
+
+// indexSlice returns the element at index, reporting whether index is in bounds.
+func indexSlice[T any](s []T, index int) (any, bool) {
+	if index < 0 || index >= len(s) {
+		return nil, false
+	}
+	return s[index], true
+}
+
+// setSliceElem stores value at index of the []T held by slice. The elements are
+// written through the slice's backing array, as reflect.Value.Index(i).Set does,
+// which avoids boxing the slice header.
+func setSliceElem[T any](slice reflect.Value, index int, value any) bool {
+	elem, ok := value.(T)
+	if !ok || index < 0 || index >= slice.Len() {
+		return false
+	}
+	//nolint:gosec // G103: slice is a []T, so its data pointer addresses Len elements of T
+	unsafe.Slice((*T)(slice.UnsafePointer()), slice.Len())[index] = elem
+	return true
+}
+
+// removeSliceElem removes the element at index of the []T held by slice, shifting
+// later elements through the backing array like setSliceElem writes them.
+func removeSliceElem[T any](slice reflect.Value, index int) reflect.Value {
+	//nolint:gosec // G103: slice is a []T, so its data pointer addresses Len elements of T
+	s := unsafe.Slice((*T)(slice.UnsafePointer()), slice.Len())
+	s = slices.Delete(s, index, index+1)
+	return slice.Slice(0, len(s))
+}
+
+// registeredSlice returns the accessor for sliceType if it was registered
+// with RegisterSliceType.
+func registeredSlice(sliceType reflect.Type) (sliceAccessor, bool) {
+	accessors := sliceAccessors.Load()
+	if accessors == nil {
+		return sliceAccessor{}, false
+	}
+	accessor, ok := (*accessors)[sliceType]
+	return accessor, ok
+}
+
+// registeredSliceAccess indexes current if its type was registered with RegisterSliceType.
+// Returns handled=false if the type is not registered.
+func registeredSliceAccess(current any, token internalToken) (any, bool, error) {
+	accessors := sliceAccessors.Load()
+	if accessors == nil {
+		return nil, false, nil
+	}
+	accessor, ok := (*accessors)[reflect.TypeOf(current)]
+	if !ok {
+		return nil, false, nil
+	}
+
+	if token.key == "-" {
+		return nil, true, ErrIndexOutOfBounds // "-" refers to nonexistent element
+	}
+	if token.index < 0 || strconv.Itoa(token.index) != token.key {
+		return nil, true, ErrInvalidIndex
+	}
+	result, ok := accessor.index(current, token.index)
+	if !ok {
+		return nil, true, ErrIndexOutOfBounds
+	}
+	return result, true, nil
+}
@@ -0,0 +1,107 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type registeredItem struct {
+	ID int `json:"id"`
+}
+
+// TestRegisterSliceType tests indexing into registered slice types.
+func TestRegisterSliceType(t *testing.T) {
+	RegisterSliceType[registeredItem]()
+	RegisterSliceType[registeredItem]() // registering twice has no effect
+
+	doc := map[string]any{"items": []registeredItem{{ID: 1}, {ID: 2}}}
+
+	t.Run("Get indexes registered slice", func(t *testing.T) {
+		val, err := Get(doc, "items", "1", "id")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, val)
+	})
+
+	t.Run("Find indexes registered slice", func(t *testing.T) {
+		ref, err := Find(doc, "items", "0")
+		assert.NoError(t, err)
+		assert.Equal(t, registeredItem{ID: 1}, ref.Val)
+		assert.Equal(t, "0", ref.Key)
+	})
+
+	t.Run("errors match unregistered slices", func(t *testing.T) {
+		unregistered := map[string]any{"items": []User{{}, {}}}
+		for _, index := range []string{"-", "2", "01", "x"} {
+			_, want := Get(unregistered, "items", index)
+			_, got := Get(doc, "items", index)
+			assert.Equal(t, want, got, index)
+
+			_, want = Find(unregistered, "items", index)
+			_, got = Find(doc, "items", index)
+			assert.Equal(t, want, got, index)
+		}
+	})
+
+	t.Run("Set stores into registered slice in place", func(t *testing.T) {
+		items := []registeredItem{{ID: 1}, {ID: 2}}
+		assert.NoError(t, Set(items, registeredItem{ID: 20}, "1"))
+		assert.Equal(t, []registeredItem{{ID: 1}, {ID: 20}}, items)
+
+		target := map[string]any{"items": items}
+		assert.NoError(t, Set(target, registeredItem{ID: 10}, "items", "0"))
+		assert.Equal(t, []registeredItem{{ID: 10}, {ID: 20}}, items)
+
+		// Nested writes and appends still go through reflection
+		assert.NoError(t, Set(target, 30, "items", "1", "id"))
+		assert.NoError(t, Set(target, registeredItem{ID: 40}, "items", "-"))
+		assert.Equal(t, []registeredItem{{ID: 10}, {ID: 30}, {ID: 40}}, target["items"])
+	})
+
+	t.Run("Delete removes from registered slice", func(t *testing.T) {
+		items := []registeredItem{{ID: 1}, {ID: 2}, {ID: 3}}
+		target := map[string]any{"items": items}
+		assert.NoError(t, Delete(target, "items", "0"))
+		assert.Equal(t, []registeredItem{{ID: 2}, {ID: 3}}, target["items"])
+		assert.Equal(t, registeredItem{}, items[2], "vacated element is zeroed")
+
+		nested := &struct {
+			Groups [][]registeredItem `json:"groups"`
+		}{Groups: [][]registeredItem{{{ID: 1}, {ID: 2}}}}
+		assert.NoError(t, DeleteByPointer(nested, "/groups/0/1"))
+		assert.Equal(t, [][]registeredItem{{{ID: 1}}}, nested.Groups)
+	})
+
+	t.Run("Delete errors match unregistered slices", func(t *testing.T) {
+		type unregisteredItem registeredItem
+		for _, index := range []string{"2", "-", "01", "x"} {
+			registered := map[string]any{"items": []registeredItem{{ID: 1}, {ID: 2}}}
+			unregistered := map[string]any{"items": []unregisteredItem{{ID: 1}, {ID: 2}}}
+			assert.Equal(t, Delete(unregistered, "items", index), Delete(registered, "items", index), index)
+			assert.Len(t, registered["items"], 2)
+		}
+	})
+
+	t.Run("Set errors match unregistered slices", func(t *testing.T) {
+		type unregisteredItem registeredItem
+		values := []struct {
+			registered, unregistered any
+		}{
+			{registeredItem{ID: 5}, unregisteredItem{ID: 5}},
+			{"wrong type", "wrong type"},
+			{nil, nil},
+		}
+		for _, value := range values {
+			for _, index := range []string{"0", "3", "01", "x"} {
+				registered := []registeredItem{{ID: 1}, {ID: 2}}
+				unregistered := []unregisteredItem{{ID: 1}, {ID: 2}}
+
+				want := Set(unregistered, value.unregistered, index)
+				got := Set(registered, value.registered, index)
+				assert.Equal(t, want, got, index)
+				assert.Equal(t, len(unregistered), len(registered))
+				assert.Equal(t, unregistered[0].ID, registered[0].ID, index)
+			}
+		}
+	})
+}
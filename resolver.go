@@ -0,0 +1,621 @@
+package jsonpointer
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"iter"
+	"maps"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Options configures how a Resolver traverses documents.
+// The zero value behaves like the package-level functions.
+type Options struct {
+	// TagName is the struct tag used to derive field names. Defaults to "json".
+	TagName string
+
+	// CaseInsensitive falls back to case-insensitive matching of map keys and
+	// struct field names when no exact match exists.
+	CaseInsensitive bool
+
+	// NormalizeUnicode falls back to comparing map keys and struct field names with
+	// the path component in Unicode Normalization Form C, so precomposed and
+	// decomposed forms of the same text, such as "café", match. The normalization
+	// tables are only consulted when this option is enabled.
+	NormalizeUnicode bool
+
+	// NormalizeKey falls back to comparing map keys and struct field names with
+	// the path component after applying NormalizeKey to both, for normalizations
+	// other than NormalizeUnicode. When both are set, NormalizeKey is applied to
+	// the NFC form. Both combine with CaseInsensitive.
+	NormalizeKey func(string) string
+
+	// MissingFieldIsNil resolves struct fields that do not exist to nil instead of
+	// failing with ErrFieldNotFound, as if every struct had every field. Further
+	// components after a missing field fail with ErrNotFound. By default, missing
+	// fields are errors, matching the package-level functions.
+	MissingFieldIsNil bool
+
+	// MaxMatches caps the number of references returned by GetAll, protecting
+	// memory when a wildcard pattern matches a huge subtree. Matching stops once
+	// the cap is exceeded and GetAll returns ErrTooManyMatches, or the first
+	// MaxMatches references if TruncateMatches is set. Zero means unlimited.
+	MaxMatches int
+
+	// TruncateMatches makes GetAll return the first MaxMatches references instead
+	// of failing when more nodes match.
+	TruncateMatches bool
+
+	// AllowFieldNameWithTag also matches exported struct fields by their Go field
+	// name when it differs from their tagged name, so a field tagged json:"name"
+	// can be addressed as "Name" too. Tagged names still take precedence, and
+	// fields tagged "-" remain unreachable.
+	AllowFieldNameWithTag bool
+
+	// OpaqueTypes lists types that are treated as leaves.
+	// Values of these types can be returned but never traversed into.
+	OpaqueTypes []reflect.Type
+
+	// MaxDepth limits the number of path components that may be resolved.
+	// Zero means unlimited.
+	MaxDepth int
+
+	// UnexportedFields allows reading unexported struct fields, matched by their
+	// Go field name, when no exported field matches. This bypasses Go's visibility
+	// rules using package unsafe and is intended for diagnostics only. Values are
+	// returned as copies; unexported fields are never written.
+	UnexportedFields bool
+
+	// StringerLeaves treats values implementing fmt.Stringer or error as leaves,
+	// like OpaqueTypes: they can be returned but never traversed into, even if
+	// they are structs, maps or slices.
+	StringerLeaves bool
+
+	// ByteSlicesAsLeaves treats byte slices ([]byte and named types based on it)
+	// as opaque binary values, as encoding/json does when it encodes them as base64
+	// strings: they can be returned but not indexed into.
+	ByteSlicesAsLeaves bool
+
+	// UnwrapValuer replaces values implementing driver.Valuer, such as ORM wrapper
+	// types, with the result of their Value method. The driver value is treated as
+	// a leaf, so traversal never descends into the wrapper's internals. Errors
+	// returned by Value are returned as-is.
+	UnwrapValuer bool
+
+	// VirtualLength makes a LengthToken path component resolve to the length of
+	// the slice, array, map or string it is applied to, as an int; strings report
+	// their number of runes. Any further components after it fail with ErrNotFound.
+	// This is not part of RFC 6901: when enabled, the token shadows map keys and
+	// struct fields of the same name on those types.
+	VirtualLength bool
+
+	// LengthToken is the path component used by VirtualLength. Defaults to "length".
+	LengthToken string
+
+	// AllowLastToken makes a LastToken path component resolve to the final element
+	// of the slice or array it is applied to; empty arrays return ErrIndexOutOfBounds.
+	// This is not part of RFC 6901. Other containers treat the token as a normal key.
+	AllowLastToken bool
+
+	// LastToken is the path component used by AllowLastToken. Defaults to "last".
+	LastToken string
+}
+
+// Resolver resolves JSON Pointers using a fixed set of Options.
+// A Resolver is immutable after construction and safe for concurrent use.
+// Struct field mappings are cached per type and shared across resolvers.
+type Resolver struct {
+	opts      Options
+	opaque    map[reflect.Type]struct{}
+	normalize func(string) string
+}
+
+// New creates a Resolver configured with the given options.
+func New(opts Options) *Resolver {
+	if opts.TagName == "" {
+		opts.TagName = "json"
+	}
+	if opts.LengthToken == "" {
+		opts.LengthToken = "length"
+	}
+	if opts.LastToken == "" {
+		opts.LastToken = "last"
+	}
+
+	opaque := make(map[reflect.Type]struct{}, len(opts.OpaqueTypes))
+	for _, t := range opts.OpaqueTypes {
+		opaque[t] = struct{}{}
+	}
+	opts.OpaqueTypes = append([]reflect.Type(nil), opts.OpaqueTypes...)
+
+	return &Resolver{opts: opts, opaque: opaque, normalize: keyNormalizer(opts)}
+}
+
+// keyNormalizer combines the NormalizeUnicode and NormalizeKey options into a
+// single normalization, or returns nil if neither is set.
+func keyNormalizer(opts Options) func(string) string {
+	custom := opts.NormalizeKey
+	switch {
+	case opts.NormalizeUnicode && custom != nil:
+		return func(s string) string { return custom(norm.NFC.String(s)) }
+	case opts.NormalizeUnicode:
+		return norm.NFC.String
+	default:
+		return custom
+	}
+}
+
+// Options returns a copy of the options the Resolver was created with.
+func (r *Resolver) Options() Options {
+	opts := r.opts
+	opts.OpaqueTypes = append([]reflect.Type(nil), opts.OpaqueTypes...)
+	return opts
+}
+
+// Get retrieves a value from document using string path components.
+func (r *Resolver) Get(doc any, path ...string) (any, error) {
+	ref, err := r.find(doc, Path(path))
+	if err != nil {
+		return nil, err
+	}
+	return ref.Val, nil
+}
+
+// Find locates a reference in document using string path components.
+func (r *Resolver) Find(doc any, path ...string) (*Reference, error) {
+	return r.find(doc, Path(path))
+}
+
+// GetByPointer retrieves a value from document using JSON Pointer string.
+func (r *Resolver) GetByPointer(doc any, pointer string) (any, error) {
+	return r.Get(doc, parseJsonPointer(pointer)...)
+}
+
+// FindByPointer locates a reference in document using JSON Pointer string.
+func (r *Resolver) FindByPointer(doc any, pointer string) (*Reference, error) {
+	return r.find(doc, parseJsonPointer(pointer))
+}
+
+// Set sets value at path in document, modifying it in place like the package-level
+// Set. Existing map keys and struct fields are matched under the configured options,
+// so a case-insensitive Resolver updates the entry it would read rather than adding
+// a new key. Unexported fields are never written.
+func (r *Resolver) Set(doc, value any, path ...string) error {
+	if r.opts.MaxDepth > 0 && len(path) > r.opts.MaxDepth {
+		return ErrMaxDepthExceeded
+	}
+	return set(r, doc, value, Path(path))
+}
+
+// SetByPointer sets value at the location of a JSON Pointer string, like Set.
+func (r *Resolver) SetByPointer(doc any, pointer string, value any) error {
+	return r.Set(doc, value, parseJsonPointer(pointer)...)
+}
+
+// Delete removes the value at path from document, modifying it in place like the
+// package-level Delete. Map keys and struct fields are matched under the configured
+// options, so a case-insensitive Resolver removes the entry it would read.
+func (r *Resolver) Delete(doc any, path ...string) error {
+	if r.opts.MaxDepth > 0 && len(path) > r.opts.MaxDepth {
+		return ErrMaxDepthExceeded
+	}
+	return remove(r, doc, Path(path))
+}
+
+// DeleteByPointer removes the value at the location of a JSON Pointer string, like Delete.
+func (r *Resolver) DeleteByPointer(doc any, pointer string) error {
+	return r.Delete(doc, parseJsonPointer(pointer)...)
+}
+
+// GetAll returns references to every node matching a wildcard pattern, like the
+// package-level GetAll, subject to MaxMatches and TruncateMatches. Matching follows
+// the package-level rules; other options do not apply to patterns.
+func (r *Resolver) GetAll(doc any, pattern string) ([]*Reference, error) {
+	segments, err := parsePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return collectMatchesLimit(doc, segments, r.opts.MaxMatches, r.opts.TruncateMatches)
+}
+
+// find walks path one component at a time, applying the configured options.
+func (r *Resolver) find(val any, path Path) (*Reference, error) {
+	if r.opts.MaxDepth > 0 && len(path) > r.opts.MaxDepth {
+		return nil, ErrMaxDepthExceeded
+	}
+	if len(path) == 0 {
+		return &Reference{Val: val, root: val, resolver: r}, nil
+	}
+
+	var obj any
+	var key string
+	current := val
+
+	for i := range path {
+		if lazy, ok := asSteppable(current); ok {
+			resolved, err := resolveLazy(lazy, path[:i])
+			if err != nil {
+				return nil, err
+			}
+			current = resolved
+		}
+
+		key = path[i]
+		obj = current
+		if isArrayEndMidPath(current, key, i == len(path)-1) {
+			return nil, ErrArrayEndNotFinal
+		}
+		next, err := r.stepFrom(current, key, i == 0)
+		if err != nil {
+			return nil, err
+		}
+		current = next
+	}
+
+	if lazy, ok := asLazy(current); ok {
+		resolved, err := resolveLazy(lazy, path)
+		if err != nil {
+			return nil, err
+		}
+		current = resolved
+	}
+
+	return &Reference{Val: current, Obj: obj, Key: key, path: path, root: val, resolver: r}, nil
+}
+
+// stepFrom resolves a single path component against current. The first
+// component of a path is resolved through the Root hook when current implements it.
+func (r *Resolver) stepFrom(current any, key string, first bool) (any, error) {
+	if first {
+		if root, ok := asRoot(current); ok {
+			return rootChild(root, key)
+		}
+	}
+	return r.step(current, key)
+}
+
+// step resolves a single path component against current.
+func (r *Resolver) step(current any, key string) (any, error) {
+	if !r.opts.UnwrapValuer {
+		return r.stepValue(current, key)
+	}
+
+	current, err := unwrapValuer(current)
+	if err != nil {
+		return nil, err
+	}
+	next, err := r.stepValue(current, key)
+	if err != nil {
+		return nil, err
+	}
+	return unwrapValuer(next)
+}
+
+// stepValue resolves a single path component against current without unwrapping
+// driver.Valuer values.
+func (r *Resolver) stepValue(current any, key string) (any, error) {
+	if current == nil {
+		return nil, ErrNotFound
+	}
+
+	if r.isOpaque(current) {
+		return nil, ErrNotFound
+	}
+	if r.opts.StringerLeaves && isStringerLeaf(current) {
+		return nil, ErrNotFound
+	}
+	if r.opts.ByteSlicesAsLeaves && isByteSlice(current) {
+		return nil, ErrNotFound
+	}
+
+	if r.opts.AllowLastToken && key == r.opts.LastToken {
+		if arrayVal, ok := arrayValue(current); ok {
+			if arrayVal.Len() == 0 {
+				return nil, ErrIndexOutOfBounds
+			}
+			return arrayVal.Index(arrayVal.Len() - 1).Interface(), nil
+		}
+	}
+	if r.opts.VirtualLength && key == r.opts.LengthToken {
+		if length, ok := virtualLength(current); ok {
+			return length, nil
+		}
+	}
+
+	// Fast path for the most common decoded JSON shape
+	if v, ok := current.(map[string]any); ok {
+		if result, exists := v[key]; exists {
+			return result, nil
+		}
+		if matches := r.keyMatcher(key); matches != nil {
+			if k, ok := smallestMatch(maps.Keys(v), matches); ok {
+				return v[k], nil
+			}
+		}
+		return nil, ErrKeyNotFound
+	}
+
+	// Arrays share the package-level access, including typed and registered slice fast paths
+	if result, handled, err := tryArrayAccess(current, internalToken{key: key, index: fastAtoi(key)}); handled {
+		return result, err
+	}
+
+	// Types implementing FieldAccessor or Sequence provide their own field lookup
+	if accessor, ok := asFieldAccessor(current); ok {
+		if result, exists := accessor.Field(key); exists {
+			return result, nil
+		}
+		return nil, ErrFieldNotFound
+	}
+
+	// Reflection fallback for other types
+	objVal := reflect.ValueOf(current)
+	for objVal.Kind() == reflect.Ptr || objVal.Kind() == reflect.Interface {
+		if objVal.IsNil() {
+			return nil, ErrNilPointer
+		}
+		objVal = objVal.Elem()
+	}
+
+	switch objVal.Kind() {
+	case reflect.Map:
+		if objVal.Type().Key().Kind() != reflect.String {
+			return nil, ErrNotFound
+		}
+		mapKey := reflect.ValueOf(key).Convert(objVal.Type().Key())
+		if mapVal := objVal.MapIndex(mapKey); mapVal.IsValid() {
+			return mapVal.Interface(), nil
+		}
+		if matches := r.keyMatcher(key); matches != nil {
+			if k, ok := smallestMatch(mapKeys(objVal), matches); ok {
+				return objVal.MapIndex(reflect.ValueOf(k).Convert(objVal.Type().Key())).Interface(), nil
+			}
+		}
+		return nil, ErrKeyNotFound
+
+	case reflect.Struct:
+		if field, ok := r.structField(objVal, key); ok {
+			return field.Interface(), nil
+		}
+		if r.opts.MissingFieldIsNil {
+			return nil, nil
+		}
+		return nil, ErrFieldNotFound
+
+	case reflect.Invalid, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128, reflect.Array,
+		reflect.Chan, reflect.Func, reflect.Interface, reflect.Ptr, reflect.Slice, reflect.String, reflect.UnsafePointer:
+		// Slices and arrays are handled by tryArrayAccess; other kinds cannot be traversed
+		return nil, ErrNotFound
+	}
+	return nil, ErrNotFound
+}
+
+// isOpaque reports whether current, or a value it points to, has one of the
+// configured opaque types.
+func (r *Resolver) isOpaque(current any) bool {
+	if len(r.opaque) == 0 {
+		return false
+	}
+	objVal := reflect.ValueOf(current)
+	for {
+		if _, ok := r.opaque[objVal.Type()]; ok {
+			return true
+		}
+		if (objVal.Kind() != reflect.Ptr && objVal.Kind() != reflect.Interface) || objVal.IsNil() {
+			return false
+		}
+		objVal = objVal.Elem()
+	}
+}
+
+// unwrapValuer returns the driver value of val if it implements driver.Valuer,
+// or val itself otherwise. Nil pointers are returned as-is.
+func unwrapValuer(val any) (any, error) {
+	valuer, ok := val.(driver.Valuer)
+	if !ok || isNull(val) {
+		return val, nil
+	}
+	return valuer.Value()
+}
+
+// isStringerLeaf reports whether val implements fmt.Stringer or error.
+func isStringerLeaf(val any) bool {
+	switch val.(type) {
+	case fmt.Stringer, error:
+		return true
+	default:
+		return false
+	}
+}
+
+// isByteSlice reports whether val is a slice of bytes.
+func isByteSlice(val any) bool {
+	if _, ok := val.([]byte); ok {
+		return true
+	}
+	t := reflect.TypeOf(val)
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
+
+// arrayValue returns val as a slice or array value, following pointers and interfaces.
+func arrayValue(val any) (reflect.Value, bool) {
+	objVal := reflect.ValueOf(val)
+	for objVal.Kind() == reflect.Ptr || objVal.Kind() == reflect.Interface {
+		if objVal.IsNil() {
+			return reflect.Value{}, false
+		}
+		objVal = objVal.Elem()
+	}
+	kind := objVal.Kind()
+	return objVal, kind == reflect.Slice || kind == reflect.Array
+}
+
+// virtualLength returns the length of a slice, array, map or string, following
+// pointers and interfaces. Strings are measured in runes.
+func virtualLength(val any) (int, bool) {
+	objVal := reflect.ValueOf(val)
+	for objVal.Kind() == reflect.Ptr || objVal.Kind() == reflect.Interface {
+		if objVal.IsNil() {
+			return 0, false
+		}
+		objVal = objVal.Elem()
+	}
+
+	kind := objVal.Kind()
+	if kind == reflect.String {
+		return utf8.RuneCountInString(objVal.String()), true
+	}
+	if kind == reflect.Slice || kind == reflect.Array || kind == reflect.Map {
+		return objVal.Len(), true
+	}
+	return 0, false
+}
+
+// keyMatcher returns a predicate reporting whether a map key or field name matches
+// key under the CaseInsensitive and normalization options, or nil if none is set.
+func (r *Resolver) keyMatcher(key string) func(string) bool {
+	normalize := r.normalize
+	switch {
+	case normalize != nil && r.opts.CaseInsensitive:
+		key = normalize(key)
+		return func(candidate string) bool {
+			return strings.EqualFold(normalize(candidate), key)
+		}
+	case normalize != nil:
+		key = normalize(key)
+		return func(candidate string) bool {
+			return normalize(candidate) == key
+		}
+	case r.opts.CaseInsensitive:
+		return func(candidate string) bool {
+			return strings.EqualFold(candidate, key)
+		}
+	default:
+		return nil
+	}
+}
+
+// smallestMatch returns the smallest of keys, in sorted order, for which matches
+// reports true, so that fallback matching does not depend on map iteration order.
+func smallestMatch(keys iter.Seq[string], matches func(string) bool) (string, bool) {
+	var match string
+	found := false
+	for k := range keys {
+		if (!found || k < match) && matches(k) {
+			match, found = k, true
+		}
+	}
+	return match, found
+}
+
+// mapKeys yields the keys of a map with a string key kind.
+func mapKeys(mapVal reflect.Value) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		entries := mapVal.MapRange()
+		for entries.Next() {
+			if !yield(entries.Key().String()) {
+				return
+			}
+		}
+	}
+}
+
+// structField looks up a struct field by its tagged name using the configured tag.
+func (r *Resolver) structField(structVal reflect.Value, key string) (reflect.Value, bool) {
+	if index, ok := r.fieldIndex(structVal.Type(), key); ok {
+		return structVal.Field(index), true
+	}
+	if r.opts.UnexportedFields {
+		return unexportedField(structVal, key)
+	}
+	return reflect.Value{}, false
+}
+
+// fieldIndex returns the index of the exported field of struct type t matching key
+// under the configured options. A nil Resolver matches like the package-level functions.
+func (r *Resolver) fieldIndex(t reflect.Type, key string) (int, bool) {
+	if r == nil {
+		index, ok := getStructFields(t)[key]
+		return index, ok
+	}
+
+	fields := getTaggedStructFields(t, r.opts.TagName)
+	if index, ok := fields[key]; ok {
+		return index, true
+	}
+	if r.opts.AllowFieldNameWithTag {
+		if field, ok := t.FieldByName(key); ok && field.IsExported() &&
+			len(field.Index) == 1 && field.Tag.Get(r.opts.TagName) != "-" {
+			return field.Index[0], true
+		}
+	}
+	if matches := r.keyMatcher(key); matches != nil {
+		// Several names may match; the field declared first wins
+		match := -1
+		for name, index := range fields {
+			if (match < 0 || index < match) && matches(name) {
+				match = index
+			}
+		}
+		if match >= 0 {
+			return match, true
+		}
+	}
+	return 0, false
+}
+
+// mapKey returns the existing key of mapVal that key refers to under the configured
+// options, or key itself if there is none. A nil Resolver always returns key.
+func (r *Resolver) mapKey(mapVal reflect.Value, key string) string {
+	if r == nil || mapVal.IsNil() {
+		return key
+	}
+	if mapVal.MapIndex(reflect.ValueOf(key).Convert(mapVal.Type().Key())).IsValid() {
+		return key
+	}
+	if matches := r.keyMatcher(key); matches != nil {
+		if k, ok := smallestMatch(mapKeys(mapVal), matches); ok {
+			return k
+		}
+	}
+	return key
+}
+
+// unexportedField reads the unexported field of structVal with the given Go name.
+// The returned value is detached from visibility checks so it can be converted
+// to an interface; it must only be read.
+func unexportedField(structVal reflect.Value, name string) (reflect.Value, bool) {
+	field, ok := structVal.Type().FieldByName(name)
+	if !ok || field.IsExported() || len(field.Index) != 1 {
+		return reflect.Value{}, false
+	}
+
+	// Unsafe access requires an addressable struct
+	fieldVal := addressable(structVal).Field(field.Index[0])
+	//nolint:gosec // G103: reading unexported fields is the documented purpose of Options.UnexportedFields
+	return reflect.NewAt(fieldVal.Type(), unsafe.Pointer(fieldVal.UnsafeAddr())).Elem(), true
+}
+
+// arrayIndex validates an array index token against an array of the given length.
+// "-" and index == length refer to the nonexistent element past the end (JSON Pointer spec).
+func arrayIndex(key string, length int) (int, error) {
+	if key == "-" {
+		return 0, ErrIndexOutOfBounds
+	}
+	index := fastAtoi(key)
+	if index < 0 || strconv.Itoa(index) != key {
+		return 0, ErrInvalidIndex
+	}
+	if index >= length {
+		return 0, ErrIndexOutOfBounds
+	}
+	return index, nil
+}
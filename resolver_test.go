@@ -0,0 +1,820 @@
+package jsonpointer
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type yamlConfig struct {
+	Host string `yaml:"host" json:"hostname"`
+	Port int    `yaml:"port"`
+}
+
+// TestResolverDefaults tests that the zero Options match the package-level functions.
+func TestResolverDefaults(t *testing.T) {
+	r := New(Options{})
+	doc := map[string]any{
+		"users": []any{
+			map[string]any{"name": "Alice"},
+		},
+		"profile": Profile{User: User{Name: "Bob"}, Location: "Tokyo"},
+	}
+
+	t.Run("get nested map value", func(t *testing.T) {
+		val, err := r.Get(doc, "users", "0", "name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", val)
+	})
+
+	t.Run("find struct field by json tag", func(t *testing.T) {
+		ref, err := r.FindByPointer(doc, "/profile/user/name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Bob", ref.Val)
+		assert.Equal(t, "name", ref.Key)
+	})
+
+	t.Run("errors match package-level functions", func(t *testing.T) {
+		pointers := []string{"/missing", "/users/1", "/users/-", "/users/01", "/profile/nope", "/users/0/name/x"}
+		for _, pointer := range pointers {
+			_, want := FindByPointer(doc, pointer)
+			_, got := r.FindByPointer(doc, pointer)
+			assert.Equal(t, want, got, pointer)
+		}
+	})
+
+	t.Run("root pointer returns document", func(t *testing.T) {
+		val, err := r.GetByPointer(doc, "")
+		assert.NoError(t, err)
+		assert.Equal(t, doc, val)
+	})
+}
+
+// TestResolverDefaultsHooks tests that the zero Options match the package-level
+// functions on documents using traversal hooks and typed slice fast paths.
+func TestResolverDefaultsHooks(t *testing.T) {
+	RegisterSliceType[registeredItem]()
+	r := New(Options{})
+
+	docs := map[string]any{
+		"root": configRoot{sections: map[string]map[string]any{
+			"server": {"port": 8080, "hosts": []any{"a", "b"}},
+		}},
+		"accessor": &dynamicRecord{data: map[string]any{"name": "accessed"}},
+		"lazy":     map[string]any{"v": lazyFunc(func() (any, error) { return []any{"x"}, nil })},
+		"text":     map[string]any{"bytes": []byte("hi"), "runes": []rune("hé")},
+		"registered": map[string]any{
+			"items": []registeredItem{{ID: 1}, {ID: 2}},
+		},
+	}
+	paths := map[string][]Path{
+		"root":       {{"server"}, {"server", "port"}, {"server", "hosts", "1"}, {"client"}, {"server", "missing"}},
+		"accessor":   {{"name"}, {"missing"}},
+		"lazy":       {{"v"}, {"v", "0"}, {"v", "1"}},
+		"text":       {{"bytes", "1"}, {"runes", "1"}, {"runes", "2"}, {"bytes", "-"}},
+		"registered": {{"items", "1", "id"}, {"items", "2"}, {"items", "01"}},
+	}
+
+	for name, doc := range docs {
+		for _, path := range paths[name] {
+			wantVal, wantErr := Get(doc, path...)
+			gotVal, gotErr := r.Get(doc, path...)
+			assert.Equal(t, wantErr, gotErr, "%s %v", name, path)
+			assert.Equal(t, wantVal, gotVal, "%s %v", name, path)
+
+			wantRef, wantErr := Find(doc, path...)
+			gotRef, gotErr := r.Find(doc, path...)
+			if gotRef != nil {
+				// Only the resolver recorded for navigation differs
+				assert.Same(t, r, gotRef.resolver)
+				gotRef.resolver = nil
+			}
+			assert.Equal(t, wantErr, gotErr, "%s %v", name, path)
+			assert.Equal(t, wantRef, gotRef, "%s %v", name, path)
+		}
+	}
+}
+
+// TestResolverTagName tests struct field resolution with a custom tag.
+func TestResolverTagName(t *testing.T) {
+	cfg := &yamlConfig{Host: "localhost", Port: 8080}
+
+	r := New(Options{TagName: "yaml"})
+	host, err := r.GetByPointer(cfg, "/host")
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost", host)
+
+	_, err = r.GetByPointer(cfg, "/hostname")
+	assert.Equal(t, ErrFieldNotFound, err)
+
+	// The default resolver still uses json tags
+	host, err = New(Options{}).GetByPointer(cfg, "/hostname")
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost", host)
+}
+
+// TestResolverCaseInsensitive tests case-insensitive key and field matching.
+func TestResolverCaseInsensitive(t *testing.T) {
+	doc := map[string]any{
+		"Users": map[string]string{"Admin": "root"},
+		"user":  User{Name: "Alice"},
+		"exact": 1,
+		"EXACT": 2,
+	}
+
+	r := New(Options{CaseInsensitive: true})
+
+	val, err := r.GetByPointer(doc, "/users/admin")
+	assert.NoError(t, err)
+	assert.Equal(t, "root", val)
+
+	val, err = r.GetByPointer(doc, "/USER/NAME")
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice", val)
+
+	// Exact matches take precedence
+	val, err = r.GetByPointer(doc, "/EXACT")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, val)
+
+	_, err = New(Options{}).GetByPointer(doc, "/users/admin")
+	assert.Equal(t, ErrKeyNotFound, err)
+}
+
+// composeAcute is a minimal NFC normalization for the test keys, composing e and E
+// followed by a combining acute accent.
+var composeAcute = strings.NewReplacer("e\u0301", "\u00e9", "E\u0301", "\u00c9").Replace
+
+// TestResolverNormalizeKey tests that keys equal after normalization match.
+func TestResolverNormalizeKey(t *testing.T) {
+	type menu struct {
+		Cafe string `json:"caf\u00e9"`
+	}
+	const (
+		nfc = "caf\u00e9"  // precomposed é
+		nfd = "cafe\u0301" // e followed by a combining acute accent
+	)
+	doc := map[string]any{
+		nfd:     "decomposed",
+		"menus": map[string]menu{"main": {Cafe: "espresso"}},
+		"typed": map[string]int{nfc: 1},
+	}
+
+	r := New(Options{NormalizeKey: composeAcute})
+
+	val, err := r.GetByPointer(doc, "/"+nfc)
+	assert.NoError(t, err)
+	assert.Equal(t, "decomposed", val)
+
+	val, err = r.GetByPointer(doc, "/menus/main/"+nfd)
+	assert.NoError(t, err)
+	assert.Equal(t, "espresso", val)
+
+	val, err = r.GetByPointer(doc, "/typed/"+nfd)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, val)
+
+	// Combined with CaseInsensitive
+	val, err = New(Options{NormalizeKey: composeAcute, CaseInsensitive: true}).GetByPointer(doc, "/CAF\u00c9")
+	assert.NoError(t, err)
+	assert.Equal(t, "decomposed", val)
+
+	_, err = New(Options{}).GetByPointer(doc, "/"+nfc)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+// TestResolverNormalizeUnicode tests that NFC and NFD forms of the same key match.
+func TestResolverNormalizeUnicode(t *testing.T) {
+	type menu struct {
+		Cafe string `json:"caf\u00e9"`
+	}
+	const (
+		nfc = "caf\u00e9"  // precomposed é
+		nfd = "cafe\u0301" // e followed by a combining acute accent
+	)
+	doc := map[string]any{
+		nfd:                  "decomposed",
+		"menus":              map[string]menu{"main": {Cafe: "espresso"}},
+		"\u00c5ngstr\u00f6m": 1, // Å and ö precomposed
+	}
+	r := New(Options{NormalizeUnicode: true})
+
+	val, err := r.GetByPointer(doc, "/"+nfc)
+	assert.NoError(t, err)
+	assert.Equal(t, "decomposed", val)
+
+	val, err = r.GetByPointer(doc, "/menus/main/"+nfd)
+	assert.NoError(t, err)
+	assert.Equal(t, "espresso", val)
+
+	// Normalization is not limited to particular characters
+	val, err = r.GetByPointer(doc, "/A\u030angstro\u0308m")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, val)
+
+	// Combined with CaseInsensitive and a custom NormalizeKey
+	r = New(Options{NormalizeUnicode: true, CaseInsensitive: true, NormalizeKey: strings.TrimSpace})
+	val, err = r.GetByPointer(doc, "/ CAF\u00c9 ")
+	assert.NoError(t, err)
+	assert.Equal(t, "decomposed", val)
+
+	_, err = New(Options{}).GetByPointer(doc, "/"+nfc)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+// TestResolverFallbackCollisions tests that keys colliding under fallback matching
+// resolve the same way on every run.
+func TestResolverFallbackCollisions(t *testing.T) {
+	type person struct {
+		Name  string `json:"Name"`
+		Alias string `json:"name"`
+	}
+	doc := map[string]any{
+		"Name":    "upper",
+		"name":    "lower",
+		"nfd":     map[string]string{"cafe\u0301": "decomposed", "caf\u00e9": "composed"},
+		"person":  person{Name: "first", Alias: "second"},
+		"entries": map[string]int{"KEY": 1, "Key": 2, "key": 3},
+	}
+	r := New(Options{CaseInsensitive: true, NormalizeKey: composeAcute})
+
+	for range 20 {
+		// Exact matches take precedence
+		val, err := r.Get(doc, "name")
+		assert.NoError(t, err)
+		assert.Equal(t, "lower", val)
+
+		// Otherwise the smallest matching map key wins
+		val, err = r.Get(doc, "NAME")
+		assert.NoError(t, err)
+		assert.Equal(t, "upper", val)
+
+		val, err = r.Get(doc, "entries", "kEY")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+
+		val, err = r.Get(doc, "nfd", "CAF\u00c9")
+		assert.NoError(t, err)
+		assert.Equal(t, "decomposed", val) // "cafe\u0301" sorts before "caf\u00e9"
+
+		// and the first declared struct field
+		val, err = r.Get(doc, "person", "NAME")
+		assert.NoError(t, err)
+		assert.Equal(t, "first", val)
+	}
+}
+
+// TestResolverMissingFieldIsNil tests that Get and Find agree on missing struct
+// fields under both settings.
+func TestResolverMissingFieldIsNil(t *testing.T) {
+	doc := map[string]any{"user": &User{Name: "Alice"}}
+
+	t.Run("default", func(t *testing.T) {
+		r := New(Options{})
+
+		_, getErr := r.GetByPointer(doc, "/user/missing")
+		_, findErr := r.FindByPointer(doc, "/user/missing")
+		assert.ErrorIs(t, getErr, ErrFieldNotFound)
+		assert.ErrorIs(t, findErr, ErrFieldNotFound)
+
+		// The package-level functions agree with the default
+		_, err := GetByPointer(doc, "/user/missing")
+		assert.ErrorIs(t, err, ErrFieldNotFound)
+		_, err = FindByPointer(doc, "/user/missing")
+		assert.ErrorIs(t, err, ErrFieldNotFound)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		r := New(Options{MissingFieldIsNil: true})
+
+		val, err := r.GetByPointer(doc, "/user/missing")
+		assert.NoError(t, err)
+		assert.Nil(t, val)
+
+		ref, err := r.FindByPointer(doc, "/user/missing")
+		assert.NoError(t, err)
+		assert.Nil(t, ref.Val)
+		assert.Equal(t, "missing", ref.Key)
+
+		// Existing fields and map keys are unaffected
+		val, err = r.GetByPointer(doc, "/user/name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", val)
+
+		_, err = r.GetByPointer(doc, "/missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+
+		_, getErr := r.GetByPointer(doc, "/user/missing/deeper")
+		_, findErr := r.FindByPointer(doc, "/user/missing/deeper")
+		assert.ErrorIs(t, getErr, ErrNotFound)
+		assert.ErrorIs(t, findErr, ErrNotFound)
+	})
+}
+
+// TestResolverMaxMatches tests that MaxMatches caps wildcard fan-out in GetAll.
+func TestResolverMaxMatches(t *testing.T) {
+	items := make([]any, 10000)
+	for i := range items {
+		items[i] = i
+	}
+	doc := map[string]any{"items": items}
+
+	t.Run("exceeding the cap fails", func(t *testing.T) {
+		refs, err := New(Options{MaxMatches: 100}).GetAll(doc, "/items/*")
+		assert.ErrorIs(t, err, ErrTooManyMatches)
+		assert.Nil(t, refs)
+	})
+
+	t.Run("exceeding the cap truncates", func(t *testing.T) {
+		refs, err := New(Options{MaxMatches: 100, TruncateMatches: true}).GetAll(doc, "/items/*")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 100)
+		assert.Equal(t, "0", refs[0].Key)
+		assert.Equal(t, 99, refs[99].Val)
+	})
+
+	t.Run("matches within the cap", func(t *testing.T) {
+		refs, err := New(Options{MaxMatches: 3}).GetAll(doc, "/items/9997/**")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 1)
+
+		refs, err = New(Options{MaxMatches: 10000}).GetAll(doc, "/items/*")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 10000)
+	})
+
+	t.Run("zero means unlimited", func(t *testing.T) {
+		refs, err := New(Options{}).GetAll(doc, "/items/*")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 10000)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := New(Options{MaxMatches: 1}).GetAll(doc, "items")
+		assert.ErrorIs(t, err, ErrPointerInvalid)
+	})
+}
+
+// TestResolverAllowFieldNameWithTag tests addressing tagged fields by their Go name.
+func TestResolverAllowFieldNameWithTag(t *testing.T) {
+	type account struct {
+		Name  string `json:"name"`
+		Owner string `json:"Name"`
+		Email string `json:"email,omitempty"`
+		Skip  string `json:"-"`
+	}
+	user := User{Name: "Alice", Age: 30, Ignored: "hidden"}
+	r := New(Options{AllowFieldNameWithTag: true})
+
+	val, err := r.Get(user, "Name")
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice", val)
+
+	val, err = r.GetByPointer(&user, "/Age")
+	assert.NoError(t, err)
+	assert.Equal(t, 30, val)
+
+	// Tagged names still work and take precedence over Go names
+	val, err = r.Get(user, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice", val)
+
+	acct := account{Name: "alice", Owner: "Bob", Email: "a@example.com", Skip: "x"}
+	val, err = r.Get(acct, "Name")
+	assert.NoError(t, err)
+	assert.Equal(t, "Bob", val)
+
+	val, err = r.Get(acct, "Email")
+	assert.NoError(t, err)
+	assert.Equal(t, "a@example.com", val)
+
+	// Ignored fields remain unreachable
+	_, err = r.Get(user, "Ignored")
+	assert.ErrorIs(t, err, ErrFieldNotFound)
+	_, err = r.Get(acct, "Skip")
+	assert.ErrorIs(t, err, ErrFieldNotFound)
+
+	// Disabled by default
+	_, err = New(Options{}).Get(user, "Name")
+	assert.ErrorIs(t, err, ErrFieldNotFound)
+}
+
+// TestResolverOpaqueTypes tests that opaque types are returned but not traversed.
+func TestResolverOpaqueTypes(t *testing.T) {
+	doc := map[string]any{
+		"user": User{Name: "Alice"},
+		"ptr":  &User{Name: "Bob"},
+	}
+
+	r := New(Options{OpaqueTypes: []reflect.Type{reflect.TypeOf(User{})}})
+
+	val, err := r.GetByPointer(doc, "/user")
+	assert.NoError(t, err)
+	assert.Equal(t, User{Name: "Alice"}, val)
+
+	_, err = r.GetByPointer(doc, "/user/name")
+	assert.Equal(t, ErrNotFound, err)
+
+	_, err = r.GetByPointer(doc, "/ptr/name")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+// TestResolverMaxDepth tests the path depth limit.
+func TestResolverMaxDepth(t *testing.T) {
+	doc := map[string]any{"a": map[string]any{"b": map[string]any{"c": 1}}}
+	r := New(Options{MaxDepth: 2})
+
+	val, err := r.Get(doc, "a", "b")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"c": 1}, val)
+
+	_, err = r.Get(doc, "a", "b", "c")
+	assert.Equal(t, ErrMaxDepthExceeded, err)
+}
+
+// TestResolverUnexportedFields tests opt-in reads of unexported struct fields.
+func TestResolverUnexportedFields(t *testing.T) {
+	user := User{Name: "Alice", private: "secret"}
+	doc := map[string]any{"user": user, "ptr": &user, "profile": Profile{User: user}}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		_, err := New(Options{}).Get(doc, "user", "private")
+		assert.Equal(t, ErrFieldNotFound, err)
+
+		_, err = Get(doc, "user", "private")
+		assert.Equal(t, ErrFieldNotFound, err)
+	})
+
+	t.Run("reads unexported fields when enabled", func(t *testing.T) {
+		r := New(Options{UnexportedFields: true})
+		for _, pointer := range []string{"/user/private", "/ptr/private", "/profile/user/private"} {
+			val, err := r.GetByPointer(doc, pointer)
+			assert.NoError(t, err, pointer)
+			assert.Equal(t, "secret", val, pointer)
+		}
+
+		// Exported fields still resolve by their tagged names
+		val, err := r.Get(doc, "user", "name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", val)
+	})
+
+	t.Run("ignored exported fields stay hidden", func(t *testing.T) {
+		_, err := New(Options{UnexportedFields: true}).Get(doc, "user", "Ignored")
+		assert.Equal(t, ErrFieldNotFound, err)
+	})
+}
+
+type codedError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *codedError) Error() string { return e.Message }
+
+type version []int
+
+func (v version) String() string { return fmt.Sprint([]int(v)) }
+
+// TestResolverStringerLeaves tests treating errors and Stringers as leaves.
+func TestResolverStringerLeaves(t *testing.T) {
+	cause := &codedError{Code: 404, Message: "missing"}
+	doc := map[string]any{
+		"err":     cause,
+		"version": version{1, 2},
+		"plain":   map[string]any{"code": 1},
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		r := New(Options{StringerLeaves: true})
+
+		val, err := r.Get(doc, "err")
+		assert.NoError(t, err)
+		assert.Same(t, cause, val)
+
+		_, err = r.Get(doc, "err", "code")
+		assert.Equal(t, ErrNotFound, err)
+		_, err = r.Get(doc, "version", "0")
+		assert.Equal(t, ErrNotFound, err)
+
+		val, err = r.Get(doc, "plain", "code")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, val)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		r := New(Options{})
+		val, err := r.Get(doc, "err", "code")
+		assert.NoError(t, err)
+		assert.Equal(t, 404, val)
+
+		val, err = r.Get(doc, "version", "1")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, val)
+	})
+}
+
+// TestResolverByteSlicesAsLeaves tests treating byte slices as binary leaves.
+func TestResolverByteSlicesAsLeaves(t *testing.T) {
+	type blob []byte
+	doc := map[string]any{
+		"data":  []byte("hi"),
+		"named": blob("ok"),
+		"ints":  []int{1, 2},
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		r := New(Options{ByteSlicesAsLeaves: true})
+
+		val, err := r.Get(doc, "data")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("hi"), val)
+
+		_, err = r.Get(doc, "data", "0")
+		assert.Equal(t, ErrNotFound, err)
+		_, err = r.Get(doc, "named", "0")
+		assert.Equal(t, ErrNotFound, err)
+
+		val, err = r.Get(doc, "ints", "1")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, val)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		r := New(Options{})
+		val, err := r.Get(doc, "data", "0")
+		assert.NoError(t, err)
+		assert.Equal(t, byte('h'), val)
+
+		val, err = r.Get(doc, "named", "1")
+		assert.NoError(t, err)
+		assert.Equal(t, byte('k'), val)
+	})
+}
+
+// nullString mimics sql.NullString, whose fields are internals of the wrapper.
+type nullString struct {
+	String string `json:"string"`
+	Valid  bool   `json:"valid"`
+}
+
+func (n nullString) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.String, nil
+}
+
+var errValuer = errors.New("valuer failed")
+
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) { return nil, errValuer }
+
+// TestResolverUnwrapValuer tests treating driver.Valuer values as their driver value.
+func TestResolverUnwrapValuer(t *testing.T) {
+	doc := map[string]any{
+		"name":    nullString{String: "Alice", Valid: true},
+		"missing": nullString{},
+		"ptr":     &nullString{String: "Bob", Valid: true},
+		"nilPtr":  (*nullString)(nil),
+		"broken":  failingValuer{},
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		r := New(Options{UnwrapValuer: true})
+
+		val, err := r.Get(doc, "name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", val)
+
+		val, err = r.Get(doc, "ptr")
+		assert.NoError(t, err)
+		assert.Equal(t, "Bob", val)
+
+		val, err = r.Get(doc, "missing")
+		assert.NoError(t, err)
+		assert.Nil(t, val)
+
+		val, err = r.Get(doc, "nilPtr")
+		assert.NoError(t, err)
+		assert.Equal(t, (*nullString)(nil), val)
+
+		// The wrapper's fields are not reachable
+		_, err = r.Get(doc, "name", "string")
+		assert.Equal(t, ErrNotFound, err)
+		_, err = r.Get(doc["name"], "valid")
+		assert.Equal(t, ErrNotFound, err)
+
+		_, err = r.Get(doc, "broken")
+		assert.Equal(t, errValuer, err)
+
+		val, err = r.Bind(doc).Get("/name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", val)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		r := New(Options{})
+		val, err := r.Get(doc, "name")
+		assert.NoError(t, err)
+		assert.Equal(t, doc["name"], val)
+
+		val, err = r.Get(doc, "name", "string")
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", val)
+	})
+}
+
+// TestResolverVirtualLength tests the opt-in length token.
+func TestResolverVirtualLength(t *testing.T) {
+	arr := [2]int{1, 2}
+	doc := map[string]any{
+		"arr":    []any{1, 2, 3},
+		"fixed":  &arr,
+		"tags":   map[string]string{"a": "x", "length": "long"},
+		"name":   "héllo",
+		"length": 42,
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		r := New(Options{VirtualLength: true})
+		tests := map[string]int{
+			"/arr/length":   3,
+			"/fixed/length": 2,
+			"/tags/length":  2,
+			"/name/length":  5,
+			"/length":       5,
+		}
+		for pointer, expected := range tests {
+			val, err := r.GetByPointer(doc, pointer)
+			assert.NoError(t, err, pointer)
+			assert.Equal(t, expected, val, pointer)
+		}
+
+		ref, err := r.FindByPointer(doc, "/arr/length")
+		assert.NoError(t, err)
+		assert.Equal(t, "length", ref.Key)
+		assert.Equal(t, doc["arr"], ref.Obj)
+
+		_, err = r.GetByPointer(doc, "/arr/length/x")
+		assert.Equal(t, ErrNotFound, err)
+
+		val, err := r.Bind(doc).Get("/arr/length")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, val)
+	})
+
+	t.Run("custom token", func(t *testing.T) {
+		r := New(Options{VirtualLength: true, LengthToken: "$len"})
+		val, err := r.GetByPointer(doc, "/arr/$len")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, val)
+
+		val, err = r.GetByPointer(doc, "/tags/length")
+		assert.NoError(t, err)
+		assert.Equal(t, "long", val)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		r := New(Options{})
+		_, err := r.GetByPointer(doc, "/arr/length")
+		assert.Equal(t, ErrInvalidIndex, err)
+
+		val, err := r.GetByPointer(doc, "/tags/length")
+		assert.NoError(t, err)
+		assert.Equal(t, "long", val)
+
+		val, err = r.GetByPointer(doc, "/length")
+		assert.NoError(t, err)
+		assert.Equal(t, 42, val)
+	})
+}
+
+// TestResolverLastToken tests the opt-in last element token.
+func TestResolverLastToken(t *testing.T) {
+	doc := map[string]any{
+		"arr":   []any{1, 2, map[string]any{"name": "end"}},
+		"typed": &[]string{"a", "b"},
+		"empty": []any{},
+		"obj":   map[string]any{"last": "key"},
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		r := New(Options{AllowLastToken: true})
+
+		val, err := r.GetByPointer(doc, "/arr/last/name")
+		assert.NoError(t, err)
+		assert.Equal(t, "end", val)
+
+		val, err = r.GetByPointer(doc, "/typed/last")
+		assert.NoError(t, err)
+		assert.Equal(t, "b", val)
+
+		_, err = r.GetByPointer(doc, "/empty/last")
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+
+		// Objects keep their keys
+		val, err = r.GetByPointer(doc, "/obj/last")
+		assert.NoError(t, err)
+		assert.Equal(t, "key", val)
+
+		val, err = New(Options{AllowLastToken: true, LastToken: "$"}).GetByPointer(doc, "/arr/$/name")
+		assert.NoError(t, err)
+		assert.Equal(t, "end", val)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		r := New(Options{})
+		_, err := r.GetByPointer(doc, "/arr/last")
+		assert.Equal(t, ErrInvalidIndex, err)
+
+		val, err := r.GetByPointer(doc, "/obj/last")
+		assert.NoError(t, err)
+		assert.Equal(t, "key", val)
+	})
+}
+
+// TestResolverSet tests that writes match keys and fields like reads do.
+func TestResolverSet(t *testing.T) {
+	t.Run("custom tag", func(t *testing.T) {
+		cfg := &yamlConfig{Host: "localhost", Port: 8080}
+		r := New(Options{TagName: "yaml"})
+
+		assert.NoError(t, r.Set(cfg, "example.com", "host"))
+		assert.NoError(t, r.SetByPointer(cfg, "/port", 9090))
+		assert.Equal(t, yamlConfig{Host: "example.com", Port: 9090}, *cfg)
+
+		assert.Equal(t, ErrFieldNotFound, r.Set(cfg, "x", "hostname"))
+	})
+
+	t.Run("case-insensitive updates existing entries", func(t *testing.T) {
+		doc := map[string]any{
+			"Users": map[string]string{"Admin": "root"},
+			"user":  &User{Name: "Alice"},
+		}
+		r := New(Options{CaseInsensitive: true})
+
+		assert.NoError(t, r.Set(doc, "admin", "users", "ADMIN"))
+		assert.Equal(t, map[string]string{"Admin": "admin"}, doc["Users"])
+
+		assert.NoError(t, r.SetByPointer(doc, "/USER/NAME", "Bob"))
+		assert.Equal(t, "Bob", doc["user"].(*User).Name)
+
+		// Keys without a match are added as given
+		assert.NoError(t, r.Set(doc, 1, "New"))
+		assert.Equal(t, 1, doc["New"])
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		doc := map[string]any{"a": map[string]any{}}
+		err := New(Options{MaxDepth: 1}).Set(doc, 1, "a", "b")
+		assert.Equal(t, ErrMaxDepthExceeded, err)
+	})
+
+	t.Run("zero options match Set", func(t *testing.T) {
+		doc := map[string]any{"Name": "x"}
+		assert.Equal(t, Set(&User{}, "x", "Name"), New(Options{}).Set(&User{}, "x", "Name"))
+		assert.Equal(t, Set(User{}, "x", "name"), New(Options{}).Set(User{}, "x", "name"))
+
+		assert.NoError(t, New(Options{}).Set(doc, "y", "name"))
+		assert.Equal(t, map[string]any{"Name": "x", "name": "y"}, doc)
+	})
+}
+
+// TestResolverOptionsCopy tests that options cannot be mutated after construction.
+func TestResolverOptionsCopy(t *testing.T) {
+	types := []reflect.Type{reflect.TypeOf(User{})}
+	r := New(Options{OpaqueTypes: types})
+	types[0] = reflect.TypeOf(Profile{})
+
+	opts := r.Options()
+	assert.Equal(t, "json", opts.TagName)
+	assert.Equal(t, reflect.TypeOf(User{}), opts.OpaqueTypes[0])
+}
+
+// TestResolverConcurrent tests concurrent use of a shared Resolver.
+func TestResolverConcurrent(t *testing.T) {
+	r := New(Options{TagName: "yaml", CaseInsensitive: true})
+	doc := map[string]any{"cfg": yamlConfig{Host: "localhost", Port: 80}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				val, err := r.GetByPointer(doc, "/cfg/PORT")
+				assert.NoError(t, err)
+				assert.Equal(t, 80, val)
+			}
+		}()
+	}
+	wg.Wait()
+}
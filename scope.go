@@ -0,0 +1,34 @@
+package jsonpointer
+
+// ScopedDoc resolves JSON Pointers relative to a subtree of a document.
+// The subtree is resolved once by Scope; later lookups start from it, so they
+// do not observe the subtree being replaced in the original document.
+type ScopedDoc struct {
+	root any
+}
+
+// Scope resolves rootPointer in doc and returns a ScopedDoc whose Get and Find
+// interpret pointers relative to it. For example, with s, _ := Scope(doc, "/users/0"),
+// s.Get("/name") resolves "/users/0/name".
+func Scope(doc any, rootPointer string) (*ScopedDoc, error) {
+	root, err := GetByPointer(doc, rootPointer)
+	if err != nil {
+		return nil, err
+	}
+	return &ScopedDoc{root: root}, nil
+}
+
+// Root returns the value at the scope's root pointer.
+func (s *ScopedDoc) Root() any {
+	return s.root
+}
+
+// Get retrieves a value using a JSON Pointer string relative to the scope.
+func (s *ScopedDoc) Get(pointer string) (any, error) {
+	return GetByPointer(s.root, pointer)
+}
+
+// Find locates a reference using a JSON Pointer string relative to the scope.
+func (s *ScopedDoc) Find(pointer string) (*Reference, error) {
+	return FindByPointer(s.root, pointer)
+}
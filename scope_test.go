@@ -0,0 +1,50 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestScope tests resolving pointers relative to a subtree.
+func TestScope(t *testing.T) {
+	doc := map[string]any{
+		"users": []any{
+			map[string]any{"name": "Alice", "tags": []any{"admin"}},
+		},
+	}
+
+	s, err := Scope(doc, "/users/0")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"name": "Alice", "tags": []any{"admin"}}, s.Root())
+
+	t.Run("scoped lookups", func(t *testing.T) {
+		val, err := s.Get("/name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", val)
+
+		ref, err := s.Find("/tags/0")
+		assert.NoError(t, err)
+		assert.Equal(t, "admin", ref.Val)
+		assert.Equal(t, "0", ref.Key)
+
+		val, err = s.Get("")
+		assert.NoError(t, err)
+		assert.Equal(t, s.Root(), val)
+	})
+
+	t.Run("scoped not found", func(t *testing.T) {
+		_, err := s.Get("/email")
+		assert.Equal(t, ErrKeyNotFound, err)
+
+		// Pointers cannot escape the scope
+		_, err = s.Get("/users")
+		assert.Equal(t, ErrKeyNotFound, err)
+	})
+
+	t.Run("invalid root", func(t *testing.T) {
+		s, err := Scope(doc, "/users/1")
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+		assert.Nil(t, s)
+	})
+}
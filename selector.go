@@ -0,0 +1,93 @@
+package jsonpointer
+
+import (
+	"reflect"
+	"runtime"
+)
+
+// PointerTo derives the JSON Pointer of a struct field from a selector returning
+// the address of that field, such as
+//
+//	PointerTo(func(p *Profile) any { return &p.User.Name }) // "/user/name"
+//
+// The selector is called once with a pointer to a zero T, and the returned
+// address is matched against the field offsets of T, using json tags for field
+// names as traversal does. Only fields of nested struct values can be selected:
+// pointer fields are nil in the zero T, so a selector dereferencing one fails with
+// ErrInvalidSelector. A selector returning the T itself yields the root pointer "".
+// It returns ErrNotStruct if T is not a struct type, and ErrInvalidSelector if
+// the selector does not return the address of an addressable field of T.
+func PointerTo[T any](selector func(*T) any) (string, error) {
+	root := new(T)
+	t := reflect.TypeOf(root).Elem()
+	if t.Kind() != reflect.Struct {
+		return "", ErrNotStruct
+	}
+
+	probe, ok := callSelector(selector, root)
+	if !ok {
+		return "", ErrInvalidSelector
+	}
+	selected := reflect.ValueOf(probe)
+	if selected.Kind() != reflect.Ptr || selected.IsNil() {
+		return "", ErrInvalidSelector
+	}
+
+	base := reflect.ValueOf(root).Pointer()
+	addr := selected.Pointer()
+	target := selected.Type().Elem()
+	if addr == base && target == t {
+		return "", nil
+	}
+	if addr < base || addr-base >= t.Size() {
+		return "", ErrInvalidSelector
+	}
+
+	path, ok := fieldPathAt(t, addr-base, target)
+	if !ok {
+		return "", ErrInvalidSelector
+	}
+	return Format(path...), nil
+}
+
+// callSelector calls selector with root, reporting false if it fails with a runtime
+// error such as a nil pointer dereference. Other panics are propagated.
+func callSelector[T any](selector func(*T) any, root *T) (selected any, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isRuntime := r.(runtime.Error); !isRuntime {
+				panic(r)
+			}
+			selected, ok = nil, false
+		}
+	}()
+	return selector(root), true
+}
+
+// fieldPathAt returns the field names leading to the field of type target at
+// offset within struct type t, descending into nested struct fields.
+func fieldPathAt(t reflect.Type, offset uintptr, target reflect.Type) (Path, bool) {
+	fields := getStructFields(t)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if offset < field.Offset || offset-field.Offset >= max(field.Type.Size(), 1) {
+			continue
+		}
+
+		// The field must be reachable by name, and not ignored or shadowed
+		name := getFieldName(field, "json")
+		if index, ok := fields[name]; !ok || index != i {
+			continue
+		}
+
+		if offset == field.Offset && field.Type == target {
+			return Path{name}, true
+		}
+		if field.Type.Kind() == reflect.Struct {
+			if rest, ok := fieldPathAt(field.Type, offset-field.Offset, target); ok {
+				return append(Path{name}, rest...), true
+			}
+		}
+	}
+	return nil, false
+}
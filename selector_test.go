@@ -0,0 +1,97 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPointerTo tests deriving JSON Pointers from struct field selectors.
+func TestPointerTo(t *testing.T) {
+	t.Run("nested fields", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			selector func(*Profile) any
+			expected string
+		}{
+			{"root", func(p *Profile) any { return p }, ""},
+			{"nested struct", func(p *Profile) any { return &p.User }, "/user"},
+			{"first nested field", func(p *Profile) any { return &p.User.Name }, "/user/name"},
+			{"later nested field", func(p *Profile) any { return &p.User.Age }, "/user/age"},
+			{"untagged field", func(p *Profile) any { return &p.User.Email }, "/user/Email"},
+			{"top-level field", func(p *Profile) any { return &p.Location }, "/location"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				pointer, err := PointerTo(tt.selector)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, pointer)
+
+				// The derived pointer resolves to the selected field
+				profile := Profile{User: User{Name: "Alice", Age: 30, Email: "a@example.com"}, Location: "Tokyo"}
+				val, err := Get(profile, Parse(pointer)...)
+				assert.NoError(t, err)
+				assert.Equal(t, reflectValue(tt.selector(&profile)), val)
+			})
+		}
+	})
+
+	t.Run("escapes special characters", func(t *testing.T) {
+		type doc struct {
+			Path string `json:"a/b~c"`
+		}
+		pointer, err := PointerTo(func(d *doc) any { return &d.Path })
+		assert.NoError(t, err)
+		assert.Equal(t, "/a~1b~0c", pointer)
+	})
+
+	t.Run("invalid selectors", func(t *testing.T) {
+		outside := User{}
+		selectors := map[string]func(*User) any{
+			"nil":           func(*User) any { return nil },
+			"not a pointer": func(u *User) any { return u.Name },
+			"outside":       func(*User) any { return &outside.Name },
+			"ignored":       func(u *User) any { return &u.Ignored },
+			"unexported":    func(u *User) any { return &u.private },
+		}
+		for name, selector := range selectors {
+			t.Run(name, func(t *testing.T) {
+				_, err := PointerTo(selector)
+				assert.ErrorIs(t, err, ErrInvalidSelector)
+			})
+		}
+	})
+
+	t.Run("nil nested pointer", func(t *testing.T) {
+		type account struct {
+			Owner *User `json:"owner"`
+		}
+		_, err := PointerTo(func(a *account) any { return &a.Owner.Name })
+		assert.ErrorIs(t, err, ErrInvalidSelector)
+
+		assert.PanicsWithValue(t, "boom", func() {
+			_, _ = PointerTo(func(*account) any { panic("boom") })
+		})
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		_, err := PointerTo(func(s *string) any { return s })
+		assert.ErrorIs(t, err, ErrNotStruct)
+	})
+}
+
+// reflectValue returns the value a selector's field address points to.
+func reflectValue(ptr any) any {
+	switch p := ptr.(type) {
+	case *Profile:
+		return *p
+	case *User:
+		return *p
+	case *string:
+		return *p
+	case *int:
+		return *p
+	default:
+		return nil
+	}
+}
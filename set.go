@@ -0,0 +1,546 @@
+package jsonpointer
+
+import (
+	"errors"
+	"reflect"
+)
+
+// SetRoot sets value at path in document and returns the resulting root.
+//
+// Containers are updated in place whenever Go allows it: map entries, slice
+// elements and fields reached through pointers are written directly, so other
+// references to those containers observe the change. When a container cannot
+// be updated in place, a new one is built and stored back into its parent:
+// appending to a slice (via "-" or an index equal to its length) may reallocate
+// it, and struct or array values held by value are copied before modification.
+// If that happens at the top level, the returned root differs from doc, so
+// callers should always use the returned value.
+//
+// Intermediate path components must already exist; only the final component
+// may create a new map entry or append to a slice. Nil pointers to maps and
+// slices along the path are allocated and assigned through the pointer.
+func SetRoot(doc, value any, path ...string) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	if doc == nil {
+		return nil, ErrNotFound
+	}
+
+	root, err := setPath(nil, reflect.ValueOf(doc), Path(path), value, false)
+	if err != nil {
+		return nil, err
+	}
+	return root.Interface(), nil
+}
+
+// Set sets value at path in document, modifying it in place.
+// See SetRoot for the aliasing semantics of the update.
+//
+// The root itself must be updatable in place: struct and array roots must be
+// passed by pointer, and appending to a top-level slice requires a pointer to
+// the slice. Otherwise Set returns ErrNotAddressable without modifying doc.
+// An empty path replaces the value a pointer root points to.
+func Set(doc, value any, path ...string) error {
+	return set(nil, doc, value, Path(path))
+}
+
+// set implements Set, matching map keys and struct fields with r; a nil r uses
+// the package defaults.
+func set(r *Resolver, doc, value any, path Path) error {
+	rootVal := reflect.ValueOf(doc)
+
+	if len(path) == 0 {
+		if rootVal.Kind() != reflect.Ptr || rootVal.IsNil() {
+			return ErrNotAddressable
+		}
+		newVal, err := assignableValue(reflect.ValueOf(value), rootVal.Elem().Type())
+		if err != nil {
+			return err
+		}
+		rootVal.Elem().Set(newVal)
+		return nil
+	}
+
+	if err := checkRootInPlace(rootVal, growsRoot(rootVal, path)); err != nil {
+		return err
+	}
+	if doc == nil {
+		return ErrNotFound
+	}
+
+	_, err := setPath(r, rootVal, path, value, false)
+	return err
+}
+
+// CanSet reports whether Set could store a value at the location of a JSON Pointer
+// string, without modifying doc. It returns false if the root cannot be updated in
+// place, as described for Set, and an error if the pointer is invalid or the location
+// cannot be reached: a missing parent, a missing struct field or an index past the
+// end of an array. Whether a particular value is assignable to the location is not
+// checked.
+func CanSet(doc any, pointer string) (bool, error) {
+	if err := validatePointerString(pointer); err != nil {
+		return false, err
+	}
+	path := parseJsonPointer(pointer)
+	rootVal := reflect.ValueOf(doc)
+
+	if len(path) == 0 {
+		return rootVal.Kind() == reflect.Ptr && !rootVal.IsNil(), nil
+	}
+	if checkRootInPlace(rootVal, growsRoot(rootVal, path)) != nil {
+		return false, nil
+	}
+	if err := canSetPath(rootVal, path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// canSetPath follows path from current like setPath, returning the error setPath
+// would return while reaching the location, without writing.
+func canSetPath(current reflect.Value, path Path) error {
+	for len(path) > 0 {
+		for current.Kind() == reflect.Interface {
+			if current.IsNil() {
+				return ErrNotFound
+			}
+			current = current.Elem()
+		}
+
+		key := path[0]
+		switch current.Kind() {
+		case reflect.Ptr:
+			if current.IsNil() {
+				// Nil pointers to maps and slices are allocated by setPath
+				elemKind := current.Type().Elem().Kind()
+				if elemKind != reflect.Map && elemKind != reflect.Slice {
+					return ErrNilPointer
+				}
+				current = reflect.Zero(current.Type().Elem())
+				continue
+			}
+			current = current.Elem()
+			continue
+
+		case reflect.Map:
+			if current.Type().Key().Kind() != reflect.String {
+				return ErrNotFound
+			}
+			if len(path) == 1 {
+				return nil
+			}
+			child := current.MapIndex(reflect.ValueOf(key).Convert(current.Type().Key()))
+			if !child.IsValid() {
+				return ErrKeyNotFound
+			}
+			current = child
+
+		case reflect.Slice, reflect.Array:
+			length := current.Len()
+			if key == "-" || fastAtoi(key) == length {
+				if len(path) > 1 || current.Kind() == reflect.Array {
+					return ErrIndexOutOfBounds
+				}
+				return nil
+			}
+			index, err := arrayIndex(key, length)
+			if err != nil {
+				return err
+			}
+			current = current.Index(index)
+
+		case reflect.Struct:
+			index, ok := getStructFields(current.Type())[key]
+			if !ok {
+				return ErrFieldNotFound
+			}
+			current = current.Field(index)
+
+		case reflect.Invalid, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+			reflect.Chan, reflect.Func, reflect.Interface, reflect.String, reflect.UnsafePointer:
+			// Handle all other reflect.Kind types not supported for JSON Pointer traversal
+			return ErrNotFound
+		}
+		path = path[1:]
+	}
+	return nil
+}
+
+// SetByPointer sets value at the location of a JSON Pointer string, overwriting
+// any existing value. It behaves like Set; see InsertByPointer for array insertion.
+func SetByPointer(doc any, pointer string, value any) error {
+	return Set(doc, value, parseJsonPointer(pointer)...)
+}
+
+// InsertByPointer adds value at the location of a JSON Pointer string with RFC 6902
+// "add" semantics. When the final component addresses an array element, value is
+// inserted before that index and later elements shift right; an index equal to the
+// length or "-" appends, and a larger index returns ErrIndexOutOfBounds. Otherwise it
+// creates or replaces the map entry or struct field like SetByPointer.
+//
+// The root must be updatable in place as for Set. Since insertion always changes the
+// length of the array, inserting into a top-level slice requires a pointer to it.
+func InsertByPointer(doc any, pointer string, value any) error {
+	path := parseJsonPointer(pointer)
+	if len(path) == 0 {
+		return Set(doc, value)
+	}
+
+	rootVal := reflect.ValueOf(doc)
+	if err := checkRootInPlace(rootVal, rootVal.Kind() == reflect.Slice && len(path) == 1); err != nil {
+		return err
+	}
+	if doc == nil {
+		return ErrNotFound
+	}
+
+	_, err := setPath(nil, rootVal, path, value, true)
+	return err
+}
+
+// ReplaceTyped replaces the existing value at the location of a JSON Pointer string
+// with value, like SetByPointer, but only if both have the same dynamic type. Any two
+// numeric values are compatible with each other, and nil only replaces nil. A number
+// stored into a location of a different numeric type, such as a float64 into an int
+// struct field, is converted if the conversion is exact; 2.0 becomes 2 while 2.5 is a
+// mismatch. Locations of interface type store value as-is. A type mismatch returns
+// ErrTypeMismatch without modifying doc; a missing location returns the lookup error.
+func ReplaceTyped(doc any, pointer string, value any) error {
+	path := parseJsonPointer(pointer)
+	existing, err := get(doc, path)
+	if err != nil {
+		return err
+	}
+	if !sameValueType(existing, value) {
+		return ErrTypeMismatch
+	}
+
+	err = Set(doc, value, path...)
+	if !errors.Is(err, ErrTypeMismatch) || value == nil || reflect.TypeOf(value) == reflect.TypeOf(existing) {
+		return err
+	}
+	// The location has the type of the existing number; convert to it if exact
+	converted, ok := convertNumber(reflect.ValueOf(value), reflect.TypeOf(existing))
+	if !ok {
+		return ErrTypeMismatch
+	}
+	return Set(doc, converted.Interface(), path...)
+}
+
+// sameValueType reports whether a and b have the same dynamic type, treating all
+// numeric kinds as one type.
+func sameValueType(a, b any) bool {
+	typeA, typeB := reflect.TypeOf(a), reflect.TypeOf(b)
+	if typeA == nil || typeB == nil {
+		return typeA == typeB
+	}
+	return typeA == typeB || (isNumericKind(typeA.Kind()) && isNumericKind(typeB.Kind()))
+}
+
+// isNumericKind reports whether values of the given kind are numbers.
+func isNumericKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Float64 && kind != reflect.Uintptr
+}
+
+// convertNumber converts the number v to the numeric type t, reporting false if
+// t cannot represent v exactly.
+func convertNumber(v reflect.Value, t reflect.Type) (reflect.Value, bool) {
+	if !isNumericKind(v.Kind()) || !isNumericKind(t.Kind()) {
+		return reflect.Value{}, false
+	}
+	converted := v.Convert(t)
+	// Round-tripping catches truncation; signed and unsigned wraparound also
+	// round-trips, so the sign must be preserved too
+	if isNegative(converted) != isNegative(v) || !converted.Convert(v.Type()).Equal(v) {
+		return reflect.Value{}, false
+	}
+	return converted, true
+}
+
+// isNegative reports whether the number v is less than zero.
+func isNegative(v reflect.Value) bool {
+	switch {
+	case v.CanInt():
+		return v.Int() < 0
+	case v.CanFloat():
+		return v.Float() < 0
+	default:
+		return false
+	}
+}
+
+// growsRoot reports whether setting path on a top-level slice appends to it.
+func growsRoot(rootVal reflect.Value, path Path) bool {
+	return rootVal.Kind() == reflect.Slice && len(path) == 1 && (path[0] == "-" || fastAtoi(path[0]) == rootVal.Len())
+}
+
+// checkRootInPlace returns ErrNotAddressable for roots that would have to be
+// replaced rather than modified in place. grows reports whether the write changes
+// the length of a top-level slice.
+func checkRootInPlace(rootVal reflect.Value, grows bool) error {
+	kind := rootVal.Kind()
+	if grows || kind == reflect.Struct || kind == reflect.Array || ((kind == reflect.Map || kind == reflect.Ptr) && rootVal.IsNil()) {
+		return ErrNotAddressable
+	}
+	return nil
+}
+
+// GetOrCompute returns the value at pointer if it exists. Otherwise it calls factory,
+// stores the result at pointer with Set and returns it, so later calls reuse it.
+// Missing intermediate locations are created as empty map[string]any objects; only
+// missing map keys, struct fields and array end positions are computed, and other
+// lookup errors are returned as-is. The factory is not called if the lookup fails
+// for another reason or the location cannot be created, and doc is only modified
+// once the value is stored. GetOrCompute is not safe for concurrent use on the same
+// document.
+func GetOrCompute(doc any, pointer string, factory func() (any, error)) (any, error) {
+	path := parseJsonPointer(pointer)
+	val, err := get(doc, path)
+	if err == nil {
+		return val, nil
+	}
+	if !errors.Is(err, ErrKeyNotFound) && !errors.Is(err, ErrFieldNotFound) && !errors.Is(err, ErrIndexOutOfBounds) {
+		return nil, err
+	}
+
+	// Find the deepest existing ancestor and check that the first missing
+	// location below it can be created before computing anything
+	existing := len(path) - 1
+	for existing > 0 {
+		if _, err := get(doc, path[:existing]); err == nil {
+			break
+		}
+		existing--
+	}
+	if err := canSet(doc, path[:existing+1]); err != nil {
+		return nil, err
+	}
+
+	value, err := factory()
+	if err != nil {
+		return nil, err
+	}
+
+	// Store the value together with its missing parents in a single Set, so a
+	// failure leaves doc unchanged
+	nested := value
+	for i := len(path) - 1; i > existing; i-- {
+		nested = map[string]any{path[i]: nested}
+	}
+	if err := Set(doc, nested, path[:existing+1]...); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// canSet returns the error Set would return while reaching the location at the
+// non-empty path, without writing.
+func canSet(doc any, path Path) error {
+	rootVal := reflect.ValueOf(doc)
+	if err := checkRootInPlace(rootVal, growsRoot(rootVal, path)); err != nil {
+		return err
+	}
+	if doc == nil {
+		return ErrNotFound
+	}
+	return canSetPath(rootVal, path)
+}
+
+// setPath sets value at path within current and returns the updated current value.
+// The returned value is current itself when it was modified in place.
+// If insert is true, a final array component inserts value instead of overwriting.
+// Map keys and struct fields are matched with r, or by the package defaults if r is nil.
+func setPath(r *Resolver, current reflect.Value, path Path, value any, insert bool) (reflect.Value, error) {
+	if len(path) == 0 {
+		return reflect.ValueOf(value), nil
+	}
+
+	// Unwrap interface values to reach the concrete container
+	for current.Kind() == reflect.Interface {
+		if current.IsNil() {
+			return reflect.Value{}, ErrNotFound
+		}
+		current = current.Elem()
+	}
+
+	key := path[0]
+	switch current.Kind() {
+	case reflect.Ptr:
+		if current.IsNil() {
+			// Nil pointers to maps and slices are allocated as needed, like RFC 6902 "add"
+			elemKind := current.Type().Elem().Kind()
+			if elemKind != reflect.Map && elemKind != reflect.Slice {
+				return reflect.Value{}, ErrNilPointer
+			}
+			current = reflect.New(current.Type().Elem())
+		}
+		elem := current.Elem()
+		newElem, err := setPath(r, elem, path, value, insert)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if err := assignTo(elem, newElem); err != nil {
+			return reflect.Value{}, err
+		}
+		return current, nil
+
+	case reflect.Map:
+		if current.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, ErrNotFound
+		}
+		mapKey := reflect.ValueOf(r.mapKey(current, key)).Convert(current.Type().Key())
+		child := current.MapIndex(mapKey)
+		if len(path) > 1 && !child.IsValid() {
+			return reflect.Value{}, ErrKeyNotFound
+		}
+		newChild, err := setPath(r, child, path[1:], value, insert)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		newChild, err = assignableValue(newChild, current.Type().Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if current.IsNil() {
+			current = reflect.MakeMap(current.Type())
+		}
+		current.SetMapIndex(mapKey, newChild)
+		return current, nil
+
+	case reflect.Slice, reflect.Array:
+		if insert && len(path) == 1 {
+			return insertElem(current, key, value)
+		}
+
+		length := current.Len()
+		if key == "-" || fastAtoi(key) == length {
+			// Appending is only meaningful for the final path component
+			if len(path) > 1 || current.Kind() == reflect.Array {
+				return reflect.Value{}, ErrIndexOutOfBounds
+			}
+			newElem, err := assignableValue(reflect.ValueOf(value), current.Type().Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			return reflect.Append(current, newElem), nil
+		}
+
+		index, err := arrayIndex(key, length)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		// Slice types registered with RegisterSliceType store final elements without reflection
+		if len(path) == 1 && current.Kind() == reflect.Slice {
+			if accessor, ok := registeredSlice(current.Type()); ok && accessor.set(current, index, value) {
+				return current, nil
+			}
+		}
+		current = addressable(current)
+		elem := current.Index(index)
+		newElem, err := setPath(r, elem, path[1:], value, insert)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if err := assignTo(elem, newElem); err != nil {
+			return reflect.Value{}, err
+		}
+		return current, nil
+
+	case reflect.Struct:
+		index, ok := r.fieldIndex(current.Type(), key)
+		if !ok {
+			return reflect.Value{}, ErrFieldNotFound
+		}
+		current = addressable(current)
+		field := current.Field(index)
+		newField, err := setPath(r, field, path[1:], value, insert)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if err := assignTo(field, newField); err != nil {
+			return reflect.Value{}, err
+		}
+		return current, nil
+
+	case reflect.Invalid, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.Chan, reflect.Func, reflect.Interface, reflect.String, reflect.UnsafePointer:
+		// Handle all other reflect.Kind types not supported for JSON Pointer traversal
+		return reflect.Value{}, ErrNotFound
+	}
+	return reflect.Value{}, ErrNotFound
+}
+
+// insertElem returns a new slice with value inserted into current before the
+// element at key, or appended for "-" or an index equal to the length.
+// Fixed-size arrays cannot grow.
+func insertElem(current reflect.Value, key string, value any) (reflect.Value, error) {
+	length := current.Len()
+	index := length
+	if key != "-" {
+		index = fastAtoi(key)
+		if index < 0 {
+			return reflect.Value{}, ErrInvalidIndex
+		}
+	}
+	if index > length || current.Kind() == reflect.Array {
+		return reflect.Value{}, ErrIndexOutOfBounds
+	}
+
+	newElem, err := assignableValue(reflect.ValueOf(value), current.Type().Elem())
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	result := reflect.MakeSlice(current.Type(), length+1, length+1)
+	reflect.Copy(result, current.Slice(0, index))
+	result.Index(index).Set(newElem)
+	reflect.Copy(result.Slice(index+1, length+1), current.Slice(index, length))
+	return result, nil
+}
+
+// addressable returns v itself if it is addressable, otherwise an addressable copy.
+// Slices are always returned as-is since their elements are addressable.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() || v.Kind() == reflect.Slice {
+		return v
+	}
+	cp := reflect.New(v.Type()).Elem()
+	cp.Set(v)
+	return cp
+}
+
+// assignTo stores newVal into the settable target.
+func assignTo(target, newVal reflect.Value) error {
+	converted, err := assignableValue(newVal, target.Type())
+	if err != nil {
+		return err
+	}
+	target.Set(converted)
+	return nil
+}
+
+// assignableValue returns v in a form that can be assigned to type t.
+// An invalid (nil) value becomes the zero value of t when t is nillable.
+func assignableValue(v reflect.Value, t reflect.Type) (reflect.Value, error) {
+	if !v.IsValid() {
+		if !isNillable(t.Kind()) {
+			return reflect.Value{}, ErrTypeMismatch
+		}
+		return reflect.Zero(t), nil
+	}
+	if v.Type().AssignableTo(t) {
+		return v, nil
+	}
+	return reflect.Value{}, ErrTypeMismatch
+}
+
+// isNillable reports whether values of the given kind can be nil.
+func isNillable(kind reflect.Kind) bool {
+	return kind == reflect.Interface || kind == reflect.Ptr || kind == reflect.Map ||
+		kind == reflect.Slice || kind == reflect.Func || kind == reflect.Chan
+}
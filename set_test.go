@@ -0,0 +1,484 @@
+package jsonpointer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSetRoot tests setting values and returning the updated root.
+func TestSetRoot(t *testing.T) {
+	t.Run("sets existing map key in place", func(t *testing.T) {
+		doc := map[string]any{"a": map[string]any{"b": 1}}
+		root, err := SetRoot(doc, 2, "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, doc["a"].(map[string]any)["b"])
+		assert.Equal(t, doc, root)
+	})
+
+	t.Run("creates new map key", func(t *testing.T) {
+		doc := map[string]any{}
+		_, err := SetRoot(doc, "v", "k")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"k": "v"}, doc)
+	})
+
+	t.Run("missing intermediate key", func(t *testing.T) {
+		doc := map[string]any{}
+		_, err := SetRoot(doc, "v", "a", "b")
+		assert.Equal(t, ErrKeyNotFound, err)
+	})
+
+	t.Run("replaces slice element in place", func(t *testing.T) {
+		arr := []any{1, 2, 3}
+		doc := map[string]any{"arr": arr}
+		_, err := SetRoot(doc, 20, "arr", "1")
+		assert.NoError(t, err)
+		assert.Equal(t, 20, arr[1], "shared backing array sees the change")
+	})
+
+	t.Run("slice growth is stored back into parent", func(t *testing.T) {
+		arr := make([]any, 3)
+		doc := map[string]any{"arr": arr}
+		_, err := SetRoot(doc, 4, "arr", "-")
+		assert.NoError(t, err)
+		assert.Equal(t, []any{nil, nil, nil, 4}, doc["arr"])
+		assert.Len(t, arr, 3, "original slice header is unchanged")
+
+		_, err = SetRoot(doc, 5, "arr", "4")
+		assert.NoError(t, err)
+		assert.Equal(t, []any{nil, nil, nil, 4, 5}, doc["arr"])
+	})
+
+	t.Run("top-level slice growth returns new root", func(t *testing.T) {
+		arr := []any{1}
+		root, err := SetRoot(arr, 2, "-")
+		assert.NoError(t, err)
+		assert.Equal(t, []any{1, 2}, root)
+		assert.Equal(t, []any{1}, arr)
+	})
+
+	t.Run("append only allowed at final component", func(t *testing.T) {
+		doc := map[string]any{"arr": []any{}}
+		_, err := SetRoot(doc, 1, "arr", "-", "x")
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+	})
+
+	t.Run("index past end", func(t *testing.T) {
+		doc := []any{1}
+		_, err := SetRoot(doc, 1, "5")
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+
+		_, err = SetRoot(doc, 1, "01")
+		assert.Equal(t, ErrInvalidIndex, err)
+	})
+
+	t.Run("struct value is copied", func(t *testing.T) {
+		user := User{Name: "Alice"}
+		root, err := SetRoot(user, "Bob", "name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Bob", root.(User).Name)
+		assert.Equal(t, "Alice", user.Name)
+	})
+
+	t.Run("struct stored in map is written back", func(t *testing.T) {
+		doc := map[string]User{"u": {Name: "Alice"}}
+		_, err := SetRoot(doc, 31, "u", "age")
+		assert.NoError(t, err)
+		assert.Equal(t, 31, doc["u"].Age)
+	})
+
+	t.Run("typed slice rejects mismatched value", func(t *testing.T) {
+		doc := map[string]any{"tags": []string{"a"}}
+		_, err := SetRoot(doc, 1, "tags", "0")
+		assert.Equal(t, ErrTypeMismatch, err)
+		assert.Equal(t, []string{"a"}, doc["tags"])
+	})
+
+	t.Run("empty path replaces root", func(t *testing.T) {
+		root, err := SetRoot(map[string]any{}, "new")
+		assert.NoError(t, err)
+		assert.Equal(t, "new", root)
+	})
+}
+
+// TestSet tests setting values in place.
+func TestSet(t *testing.T) {
+	t.Run("map root", func(t *testing.T) {
+		doc := map[string]any{"a": []any{1}}
+		assert.NoError(t, Set(doc, 2, "a", "-"))
+		assert.Equal(t, []any{1, 2}, doc["a"])
+	})
+
+	t.Run("pointer to slice root can grow", func(t *testing.T) {
+		arr := []any{1}
+		assert.NoError(t, Set(&arr, 2, "-"))
+		assert.Equal(t, []any{1, 2}, arr)
+	})
+
+	t.Run("slice root cannot grow", func(t *testing.T) {
+		arr := []any{1}
+		assert.Equal(t, ErrNotAddressable, Set(arr, 2, "-"))
+		assert.Equal(t, ErrNotAddressable, Set(arr, 2, "1"))
+		assert.NoError(t, Set(arr, 2, "0"))
+		assert.Equal(t, []any{2}, arr)
+	})
+
+	t.Run("struct root must be a pointer", func(t *testing.T) {
+		user := User{Name: "Alice"}
+		assert.Equal(t, ErrNotAddressable, Set(user, "Bob", "name"))
+		assert.NoError(t, Set(&user, "Bob", "name"))
+		assert.Equal(t, "Bob", user.Name)
+	})
+
+	t.Run("struct pointer root resolves json tags", func(t *testing.T) {
+		user := &User{Name: "Alice", Email: "alice@example.com"}
+		assert.NoError(t, Set(user, "Bob", "name"))
+		assert.NoError(t, Set(user, "bob@example.com", "Email"))
+		assert.NoError(t, Set(user, 42, "age"))
+		assert.Equal(t, &User{Name: "Bob", Age: 42, Email: "bob@example.com"}, user)
+
+		// Go field names are hidden by json tags, and ignored fields stay unwritable
+		assert.Equal(t, ErrFieldNotFound, Set(user, "x", "Name"))
+		assert.Equal(t, ErrFieldNotFound, Set(user, "x", "Ignored"))
+		assert.Equal(t, ErrTypeMismatch, Set(user, "old", "age"))
+	})
+
+	t.Run("multiple levels of pointers", func(t *testing.T) {
+		user := &User{Name: "Alice"}
+		userPtr := &user
+		assert.NoError(t, Set(&userPtr, "Bob", "name"))
+		assert.Equal(t, "Bob", user.Name)
+	})
+
+	t.Run("empty path writes through pointer", func(t *testing.T) {
+		var doc any = map[string]any{}
+		assert.NoError(t, Set(&doc, "replaced"))
+		assert.Equal(t, "replaced", doc)
+		assert.Equal(t, ErrNotAddressable, Set(doc, "x"))
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		doc := map[string]any{"u": (*User)(nil)}
+		assert.Equal(t, ErrNilPointer, Set(doc, "Bob", "u", "name"))
+	})
+
+	t.Run("nil map pointer is allocated", func(t *testing.T) {
+		var m *map[string]any
+		assert.NoError(t, Set(&m, "v", "k"))
+		assert.Equal(t, &map[string]any{"k": "v"}, m)
+	})
+
+	t.Run("nil slice pointer is allocated on append", func(t *testing.T) {
+		doc := map[string]any{"items": (*[]any)(nil)}
+		assert.NoError(t, Set(doc, 1, "items", "-"))
+		assert.Equal(t, &[]any{1}, doc["items"])
+
+		doc["more"] = (*[]any)(nil)
+		assert.Equal(t, ErrIndexOutOfBounds, Set(doc, 1, "more", "1"))
+		assert.Nil(t, doc["more"])
+	})
+
+	t.Run("nil map pointer root is not addressable", func(t *testing.T) {
+		var m *map[string]any
+		assert.Equal(t, ErrNotAddressable, Set(m, "v", "k"))
+	})
+}
+
+// TestSetByPointer tests overwriting values using JSON Pointer strings.
+func TestSetByPointer(t *testing.T) {
+	doc := map[string]any{"arr": []any{1, 2, 3}, "a/b": map[string]any{}}
+	assert.NoError(t, SetByPointer(doc, "/arr/1", "x"))
+	assert.Equal(t, []any{1, "x", 3}, doc["arr"])
+
+	assert.NoError(t, SetByPointer(doc, "/a~1b/c", true))
+	assert.Equal(t, map[string]any{"c": true}, doc["a/b"])
+}
+
+// TestInsertByPointer tests RFC 6902 "add" semantics.
+func TestInsertByPointer(t *testing.T) {
+	t.Run("insert shifts later elements right", func(t *testing.T) {
+		original := []any{"a", "b", "c"}
+		doc := map[string]any{"arr": original}
+		assert.NoError(t, InsertByPointer(doc, "/arr/1", "x"))
+		assert.Equal(t, []any{"a", "x", "b", "c"}, doc["arr"])
+		assert.Equal(t, []any{"a", "b", "c"}, original)
+
+		assert.NoError(t, InsertByPointer(doc, "/arr/0", "first"))
+		assert.Equal(t, []any{"first", "a", "x", "b", "c"}, doc["arr"])
+	})
+
+	t.Run("index equal to length and dash append", func(t *testing.T) {
+		doc := map[string]any{"arr": []int{1}}
+		assert.NoError(t, InsertByPointer(doc, "/arr/1", 2))
+		assert.NoError(t, InsertByPointer(doc, "/arr/-", 3))
+		assert.Equal(t, []int{1, 2, 3}, doc["arr"])
+	})
+
+	t.Run("invalid indices", func(t *testing.T) {
+		doc := map[string]any{"arr": []any{1}, "fixed": [1]int{1}}
+		assert.Equal(t, ErrIndexOutOfBounds, InsertByPointer(doc, "/arr/2", 0))
+		assert.Equal(t, ErrInvalidIndex, InsertByPointer(doc, "/arr/01", 0))
+		assert.Equal(t, ErrInvalidIndex, InsertByPointer(doc, "/arr/x", 0))
+		assert.Equal(t, ErrTypeMismatch, InsertByPointer(map[string]any{"arr": []int{}}, "/arr/0", "s"))
+		assert.Equal(t, ErrIndexOutOfBounds, InsertByPointer(doc, "/fixed/0", 0))
+		assert.Equal(t, []any{1}, doc["arr"])
+	})
+
+	t.Run("objects replace or create", func(t *testing.T) {
+		user := &User{Name: "Alice"}
+		doc := map[string]any{"k": 1, "user": user}
+		assert.NoError(t, InsertByPointer(doc, "/k", 2))
+		assert.NoError(t, InsertByPointer(doc, "/new", 3))
+		assert.NoError(t, InsertByPointer(doc, "/user/name", "Bob"))
+		assert.Equal(t, 2, doc["k"])
+		assert.Equal(t, 3, doc["new"])
+		assert.Equal(t, "Bob", user.Name)
+	})
+
+	t.Run("nested arrays", func(t *testing.T) {
+		doc := map[string]any{"rows": []any{[]any{1, 3}}}
+		assert.NoError(t, InsertByPointer(doc, "/rows/0/1", 2))
+		assert.Equal(t, []any{[]any{1, 2, 3}}, doc["rows"])
+	})
+
+	t.Run("top-level slice requires a pointer", func(t *testing.T) {
+		arr := []any{1, 3}
+		assert.Equal(t, ErrNotAddressable, InsertByPointer(arr, "/1", 2))
+		assert.NoError(t, InsertByPointer(&arr, "/1", 2))
+		assert.Equal(t, []any{1, 2, 3}, arr)
+	})
+
+	t.Run("root replaces the document", func(t *testing.T) {
+		var doc any = []any{1}
+		assert.NoError(t, InsertByPointer(&doc, "", "replaced"))
+		assert.Equal(t, "replaced", doc)
+		assert.Equal(t, ErrNotFound, InsertByPointer(nil, "/a", 1))
+	})
+}
+
+// TestReplaceTyped tests type-preserving replacement.
+func TestReplaceTyped(t *testing.T) {
+	doc := map[string]any{"port": 80, "host": "localhost", "tags": []any{"a"}, "none": nil}
+
+	assert.NoError(t, ReplaceTyped(doc, "/port", 8080))
+	assert.Equal(t, 8080, doc["port"])
+
+	assert.Equal(t, ErrTypeMismatch, ReplaceTyped(doc, "/port", "8080"))
+	assert.Equal(t, 8080, doc["port"])
+
+	assert.NoError(t, ReplaceTyped(doc, "/port", 443.0))
+	assert.Equal(t, 443.0, doc["port"])
+
+	assert.NoError(t, ReplaceTyped(doc, "/tags/0", "b"))
+	assert.Equal(t, []any{"b"}, doc["tags"])
+	assert.Equal(t, ErrTypeMismatch, ReplaceTyped(doc, "/tags", map[string]any{}))
+	assert.Equal(t, ErrTypeMismatch, ReplaceTyped(doc, "/host", nil))
+	assert.Equal(t, ErrTypeMismatch, ReplaceTyped(doc, "/none", 1))
+	assert.NoError(t, ReplaceTyped(doc, "/none", nil))
+
+	// Only existing locations can be replaced
+	assert.Equal(t, ErrKeyNotFound, ReplaceTyped(doc, "/missing", 1))
+	assert.Equal(t, ErrIndexOutOfBounds, ReplaceTyped(doc, "/tags/-", "c"))
+	assert.NotContains(t, doc, "missing")
+
+	user := &User{Name: "Alice", Age: 30}
+	assert.NoError(t, ReplaceTyped(user, "/age", 31))
+	assert.Equal(t, 31, user.Age)
+	assert.Equal(t, ErrTypeMismatch, ReplaceTyped(user, "/name", 1))
+
+	t.Run("numbers convert to typed locations", func(t *testing.T) {
+		user := &User{Age: 30}
+		assert.NoError(t, ReplaceTyped(user, "/age", 2.0))
+		assert.Equal(t, 2, user.Age)
+
+		assert.NoError(t, ReplaceTyped(user, "/age", uint8(40)))
+		assert.Equal(t, 40, user.Age)
+
+		// Conversions that lose information are mismatches
+		assert.Equal(t, ErrTypeMismatch, ReplaceTyped(user, "/age", 2.5))
+		assert.Equal(t, ErrTypeMismatch, ReplaceTyped(user, "/age", uint64(1<<63)))
+		assert.Equal(t, 40, user.Age)
+
+		counts := map[string]uint{"n": 1}
+		assert.NoError(t, ReplaceTyped(counts, "/n", 7))
+		assert.Equal(t, uint(7), counts["n"])
+		assert.Equal(t, ErrTypeMismatch, ReplaceTyped(counts, "/n", -1))
+		assert.Equal(t, ErrTypeMismatch, ReplaceTyped(counts, "/n", -1.0))
+		assert.Equal(t, uint(7), counts["n"])
+	})
+
+	t.Run("uintptr is not a number", func(t *testing.T) {
+		values := map[string]any{"n": 1}
+		assert.Equal(t, ErrTypeMismatch, ReplaceTyped(values, "/n", uintptr(1)))
+	})
+}
+
+// TestPathClone tests that cloned paths do not alias the original.
+func TestPathClone(t *testing.T) {
+	path := Path{"a", "b"}
+	clone := path.Clone()
+	clone[0] = "x"
+	assert.Equal(t, Path{"a", "b"}, path)
+	assert.Equal(t, Path{"x", "b"}, clone)
+
+	assert.Nil(t, Path(nil).Clone())
+	assert.Equal(t, Path{}, Path{}.Clone())
+}
+
+var errCompute = errors.New("compute failed")
+
+// TestGetOrCompute tests memoizing computed values in a document.
+func TestGetOrCompute(t *testing.T) {
+	t.Run("second call returns cached value", func(t *testing.T) {
+		doc := map[string]any{}
+		calls := 0
+		factory := func() (any, error) {
+			calls++
+			return calls * 10, nil
+		}
+
+		val, err := GetOrCompute(doc, "/cache/users/alice", factory)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, val)
+
+		val, err = GetOrCompute(doc, "/cache/users/alice", factory)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, val)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, map[string]any{"cache": map[string]any{"users": map[string]any{"alice": 10}}}, doc)
+	})
+
+	t.Run("existing value skips factory", func(t *testing.T) {
+		doc := map[string]any{"a": nil}
+		val, err := GetOrCompute(doc, "/a", func() (any, error) {
+			t.Fatal("factory called")
+			return nil, nil
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, val)
+	})
+
+	t.Run("appends to arrays", func(t *testing.T) {
+		doc := map[string]any{"items": []any{"a"}}
+		val, err := GetOrCompute(doc, "/items/-", func() (any, error) { return "b", nil })
+		assert.NoError(t, err)
+		assert.Equal(t, "b", val)
+		assert.Equal(t, []any{"a", "b"}, doc["items"])
+	})
+
+	t.Run("factory error leaves document unchanged", func(t *testing.T) {
+		doc := map[string]any{}
+		_, err := GetOrCompute(doc, "/a/b", func() (any, error) { return nil, errCompute })
+		assert.ErrorIs(t, err, errCompute)
+		assert.Empty(t, doc)
+	})
+
+	t.Run("unreachable location leaves document unchanged", func(t *testing.T) {
+		doc := map[string]any{"arr": []any{1, 2}}
+		_, err := GetOrCompute(doc, "/arr/5/x", func() (any, error) {
+			t.Fatal("factory called")
+			return nil, nil
+		})
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+		assert.Equal(t, map[string]any{"arr": []any{1, 2}}, doc)
+
+		_, err = GetOrCompute(User{}, "/missing", func() (any, error) {
+			t.Fatal("factory called")
+			return nil, nil
+		})
+		assert.Equal(t, ErrNotAddressable, err)
+	})
+
+	t.Run("failed store leaves document unchanged", func(t *testing.T) {
+		doc := map[string]map[string]int{"a": {}}
+		_, err := GetOrCompute(doc, "/a/b/c", func() (any, error) { return 1, nil })
+		assert.Equal(t, ErrTypeMismatch, err)
+		assert.Equal(t, map[string]map[string]int{"a": {}}, doc)
+	})
+
+	t.Run("other lookup errors are returned without computing", func(t *testing.T) {
+		doc := map[string]any{"items": []any{"a"}, "name": "x"}
+		for _, pointer := range []string{"/items/01", "/name/first"} {
+			_, err := GetOrCompute(doc, pointer, func() (any, error) {
+				t.Fatal("factory called")
+				return nil, nil
+			})
+			assert.Error(t, err, pointer)
+		}
+	})
+}
+
+// TestCanSet tests that CanSet predicts whether Set succeeds without writing.
+func TestCanSet(t *testing.T) {
+	type doc struct {
+		Name  string         `json:"name"`
+		Tags  []string       `json:"tags"`
+		Meta  map[string]any `json:"meta"`
+		Fixed [2]int         `json:"fixed"`
+		User  *User          `json:"user"`
+	}
+
+	tests := []struct {
+		name    string
+		doc     func() any
+		pointer string
+		value   any
+		ok      bool
+		err     error
+	}{
+		{"map key", func() any { return map[string]any{"a": 1} }, "/a", 2, true, nil},
+		{"new map key", func() any { return map[string]any{} }, "/b", 2, true, nil},
+		{"field of pointer struct root", func() any { return &doc{} }, "/name", "x", true, nil},
+		{"field of non-pointer struct root", func() any { return doc{} }, "/name", "x", false, nil},
+		{"element of slice root", func() any { return []any{1} }, "/0", 2, true, nil},
+		{"append to slice root", func() any { return []any{1} }, "/-", 2, false, nil},
+		{"append to pointer slice root", func() any { return &[]any{1} }, "/-", 2, true, nil},
+		{"append to nested slice", func() any { return &doc{} }, "/tags/-", "t", true, nil},
+		{"key of nil nested map", func() any { return &doc{} }, "/meta/k", 1, true, nil},
+		{"struct held by value in map", func() any { return map[string]any{"u": User{}} }, "/u/name", "x", true, nil},
+		{"array element", func() any { return &doc{} }, "/fixed/1", 3, true, nil},
+		{"append to array", func() any { return &doc{} }, "/fixed/-", 3, false, ErrIndexOutOfBounds},
+		{"missing parent", func() any { return map[string]any{} }, "/a/b", 1, false, ErrKeyNotFound},
+		{"missing field", func() any { return &doc{} }, "/missing", 1, false, ErrFieldNotFound},
+		{"through nil struct pointer", func() any { return &doc{} }, "/user/name", "x", false, ErrNilPointer},
+		{"into scalar", func() any { return map[string]any{"a": 1} }, "/a/b", 1, false, ErrNotFound},
+		{"invalid index", func() any { return []any{1} }, "/01", 1, false, ErrInvalidIndex},
+		{"root of pointer", func() any { return &doc{} }, "", doc{}, true, nil},
+		{"root of map", func() any { return map[string]any{} }, "", 1, false, nil},
+		{"nil map root", func() any { return map[string]any(nil) }, "/a", 1, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.doc()
+			ok, err := CanSet(d, tt.pointer)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.err, err)
+
+			// The prediction matches what Set does
+			setErr := SetByPointer(d, tt.pointer, tt.value)
+			switch {
+			case tt.ok:
+				assert.NoError(t, setErr)
+			case tt.err == nil:
+				assert.Equal(t, ErrNotAddressable, setErr)
+			default:
+				assert.Equal(t, tt.err, setErr)
+			}
+		})
+	}
+
+	t.Run("does not modify doc", func(t *testing.T) {
+		d := map[string]any{"list": []any{1}}
+		ok, err := CanSet(d, "/list/-")
+		assert.True(t, ok)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"list": []any{1}}, d)
+	})
+
+	t.Run("invalid pointer", func(t *testing.T) {
+		_, err := CanSet(map[string]any{}, "a")
+		assert.Equal(t, ErrPointerInvalid, err)
+	})
+}
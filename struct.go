@@ -1,6 +1,7 @@
 package jsonpointer
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"sync"
@@ -47,39 +48,115 @@ func getStructFields(t reflect.Type) structFields {
 		return cached.(structFields)
 	}
 
-	// Build field mapping
+	// Build field mapping and store in cache
+	fields := buildStructFields(t, "json")
+	structFieldsCache.Store(t, fields)
+	return fields
+}
+
+// taggedFieldsKey identifies a cached field mapping for a struct type and tag name.
+type taggedFieldsKey struct {
+	typ reflect.Type
+	tag string
+}
+
+// taggedFieldsCache stores field mappings for struct tags other than "json"
+var taggedFieldsCache sync.Map
+
+// getTaggedStructFields gets field mapping for struct type using the given tag name.
+// The "json" tag shares the default cache used by the package-level functions.
+func getTaggedStructFields(t reflect.Type, tag string) structFields {
+	if tag == "" || tag == "json" {
+		return getStructFields(t)
+	}
+
+	key := taggedFieldsKey{typ: t, tag: tag}
+	if cached, ok := taggedFieldsCache.Load(key); ok {
+		return cached.(structFields)
+	}
+
+	fields := buildStructFields(t, tag)
+	taggedFieldsCache.Store(key, fields)
+	return fields
+}
+
+// buildStructFields builds the field mapping for struct type using the given tag name.
+// Tagged names take precedence over Go field names, and the first field in
+// declaration order wins when several fields share a name.
+func buildStructFields(t reflect.Type, tag string) structFields {
 	fields := make(structFields)
 	numField := t.NumField()
 
-	for i := 0; i < numField; i++ {
-		field := t.Field(i)
-
-		// Skip unexported fields
-		if !field.IsExported() {
-			continue
+	for _, tagged := range [2]bool{true, false} {
+		for i := 0; i < numField; i++ {
+			field := t.Field(i)
+
+			// Skip unexported fields
+			if !field.IsExported() {
+				continue
+			}
+
+			// json:"-" means ignore field, while json:"-," names the field "-"
+			value := field.Tag.Get(tag)
+			if value == "-" {
+				continue
+			}
+
+			hasTagName := value != "" && value[0] != ','
+			if hasTagName != tagged {
+				continue
+			}
+			name := getFieldName(field, tag)
+			if _, exists := fields[name]; !exists {
+				fields[name] = i
+			}
 		}
+	}
 
-		// Get field name
-		name := getFieldName(field)
-		if name == "-" {
-			continue // json:"-" means ignore field
-		}
+	return fields
+}
 
-		fields[name] = i
+// ValidateStructTags reports ambiguous json tags on the struct type of v, which may
+// be a struct, a pointer to one or a reflect.Type. When several exported fields are
+// tagged with the same name, traversal silently resolves that name to the first of
+// them in declaration order; ValidateStructTags returns an error wrapping
+// ErrDuplicateFieldName that names the first such duplicate instead.
+// It returns ErrNotStruct if v is not a struct type.
+func ValidateStructTags(v any) error {
+	t, ok := v.(reflect.Type)
+	if !ok {
+		t = reflect.TypeOf(v)
+	}
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return ErrNotStruct
 	}
 
-	// Store in cache
-	structFieldsCache.Store(t, fields)
-	return fields
+	seen := make(map[string]string, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := field.Tag.Get("json")
+		if !field.IsExported() || value == "-" || value == "" || value[0] == ',' {
+			continue
+		}
+		name := getFieldName(field, "json")
+		if first, exists := seen[name]; exists {
+			return fmt.Errorf("%w: %q used by fields %s and %s of %s", ErrDuplicateFieldName, name, first, field.Name, t)
+		}
+		seen[name] = field.Name
+	}
+	return nil
 }
 
-// getFieldName gets the JSON name of field, supports basic JSON tags
-func getFieldName(field reflect.StructField) string {
-	// Check JSON tag
-	tag := field.Tag.Get("json")
-	if tag != "" {
+// getFieldName gets the tagged name of field, supports basic JSON tag syntax
+func getFieldName(field reflect.StructField, tag string) string {
+	// Check struct tag
+	value := field.Tag.Get(tag)
+	if value != "" {
 		// Take the part before comma as field name
-		name := strings.Split(tag, ",")[0]
+		name := strings.Split(value, ",")[0]
 		if name != "" {
 			return name
 		}
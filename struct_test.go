@@ -1,7 +1,9 @@
 package jsonpointer
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -207,6 +209,62 @@ func TestMixedMapAndStruct(t *testing.T) {
 	}
 }
 
+func TestAnonymousStruct(t *testing.T) {
+	// Anonymous struct types have no name but resolve like named ones
+	data := map[string]any{
+		"x": struct {
+			Name  string `json:"name"`
+			Count int
+			Inner *struct {
+				ID string `json:"id"`
+			} `json:"inner"`
+		}{
+			Name:  "anon",
+			Count: 3,
+			Inner: &struct {
+				ID string `json:"id"`
+			}{ID: "a1"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		pointer  string
+		expected any
+	}{
+		{"tagged field", "/x/name", "anon"},
+		{"untagged field", "/x/Count", 3},
+		{"nested anonymous pointer", "/x/inner/id", "a1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Get(data, Parse(tt.pointer)...)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Get() = %v, want %v", result, tt.expected)
+			}
+
+			ref, err := FindByPointer(data, tt.pointer)
+			if err != nil {
+				t.Fatalf("FindByPointer() error = %v", err)
+			}
+			if ref.Val != tt.expected {
+				t.Errorf("FindByPointer() = %v, want %v", ref.Val, tt.expected)
+			}
+		})
+	}
+
+	t.Run("missing field", func(t *testing.T) {
+		_, err := Get(data, "x", "missing")
+		if !errors.Is(err, ErrFieldNotFound) {
+			t.Errorf("Get() error = %v, want %v", err, ErrFieldNotFound)
+		}
+	})
+}
+
 func TestFindByPointerWithStruct(t *testing.T) {
 	user := User{
 		Name:  "Grace",
@@ -624,6 +682,8 @@ func TestMixedDataEdgeCases(t *testing.T) {
 				map[string]any{"type": "map", "value": 42},
 				"plain string",
 				123,
+				&Profile{User: User{Name: "Pointer in array"}, Location: "Paris"},
+				map[string]string{"kind": "typed map"},
 			},
 		},
 	}
@@ -640,6 +700,9 @@ func TestMixedDataEdgeCases(t *testing.T) {
 		{"Map in mixed array", Path{"nested", "array_with_mixed", "1", "type"}, "map"},
 		{"String in mixed array", Path{"nested", "array_with_mixed", "2"}, "plain string"},
 		{"Number in mixed array", Path{"nested", "array_with_mixed", "3"}, 123},
+		{"Struct pointer in mixed array", Path{"nested", "array_with_mixed", "4", "location"}, "Paris"},
+		{"Nested struct via pointer in mixed array", Path{"nested", "array_with_mixed", "4", "user", "name"}, "Pointer in array"},
+		{"String map in mixed array", Path{"nested", "array_with_mixed", "5", "kind"}, "typed map"},
 	}
 
 	for _, tt := range tests {
@@ -648,8 +711,36 @@ func TestMixedDataEdgeCases(t *testing.T) {
 			if result != tt.expected {
 				t.Errorf("Get() = %v, want %v", result, tt.expected)
 			}
+
+			// All traversal engines agree on mixed arrays
+			ref, err := Find(data, tt.path...)
+			if err != nil || ref.Val != tt.expected {
+				t.Errorf("Find() = %v, %v, want %v", ref, err, tt.expected)
+			}
+			ref, err = FindByPointer(data, Format(tt.path...))
+			if err != nil || ref.Val != tt.expected {
+				t.Errorf("FindByPointer() = %v, %v, want %v", ref, err, tt.expected)
+			}
 		})
 	}
+
+	// Misses on typed elements of mixed arrays report the element's error
+	missing := []struct {
+		path Path
+		err  error
+	}{
+		{Path{"nested", "array_with_mixed", "4", "missing"}, ErrFieldNotFound},
+		{Path{"nested", "array_with_mixed", "5", "missing"}, ErrKeyNotFound},
+		{Path{"nested", "array_with_mixed", "2", "missing"}, ErrNotFound},
+	}
+	for _, tt := range missing {
+		if _, err := Get(data, tt.path...); !errors.Is(err, tt.err) {
+			t.Errorf("Get(%v) error = %v, want %v", tt.path, err, tt.err)
+		}
+		if _, err := Find(data, tt.path...); !errors.Is(err, tt.err) {
+			t.Errorf("Find(%v) error = %v, want %v", tt.path, err, tt.err)
+		}
+	}
 }
 
 // Test nil pointer handling
@@ -1023,3 +1114,189 @@ func TestArrayBoundsChecking(t *testing.T) {
 		}
 	})
 }
+
+// Test map values holding pointers to structs
+func TestMapWithStructPointerValues(t *testing.T) {
+	user := &User{Name: "Alice", Age: 30, Email: "alice@example.com"}
+	doc := map[string]any{
+		"user":  user,
+		"users": []any{user},
+		"nil":   (*User)(nil),
+	}
+
+	tests := []struct {
+		name     string
+		pointer  string
+		expected any
+	}{
+		{"JSON tag field", "/user/name", "Alice"},
+		{"field name", "/user/Email", "alice@example.com"},
+		{"through array", "/users/0/age", 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val, err := GetByPointer(doc, tt.pointer)
+			if err != nil || val != tt.expected {
+				t.Errorf("GetByPointer() = %v, %v, want %v", val, err, tt.expected)
+			}
+
+			ref, err := FindByPointer(doc, tt.pointer)
+			if err != nil || ref.Val != tt.expected {
+				t.Errorf("FindByPointer() = %v, %v, want %v", ref, err, tt.expected)
+			}
+
+			ref, err = Find(doc, Parse(tt.pointer)...)
+			if err != nil || ref.Val != tt.expected {
+				t.Errorf("Find() = %v, %v, want %v", ref, err, tt.expected)
+			}
+		})
+	}
+
+	t.Run("missing field", func(t *testing.T) {
+		if _, err := GetByPointer(doc, "/user/missing"); !errors.Is(err, ErrFieldNotFound) {
+			t.Errorf("GetByPointer() error = %v, want %v", err, ErrFieldNotFound)
+		}
+	})
+
+	t.Run("nil struct pointer", func(t *testing.T) {
+		if _, err := GetByPointer(doc, "/nil/name"); !errors.Is(err, ErrNilPointer) {
+			t.Errorf("GetByPointer() error = %v, want %v", err, ErrNilPointer)
+		}
+	})
+}
+
+func TestStructTagOptionsOnly(t *testing.T) {
+	type Options struct {
+		Field string `json:",omitempty"`
+		Named string `json:"named,omitempty"`
+	}
+	doc := Options{Field: "value", Named: "named value"}
+
+	tests := []struct {
+		name     string
+		pointer  string
+		expected any
+	}{
+		{"options-only tag uses field name", "/Field", "value"},
+		{"named tag with options", "/named", "named value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val, err := GetByPointer(doc, tt.pointer)
+			if err != nil || val != tt.expected {
+				t.Errorf("GetByPointer() = %v, %v, want %v", val, err, tt.expected)
+			}
+
+			ref, err := FindByPointer(doc, tt.pointer)
+			if err != nil || ref.Val != tt.expected {
+				t.Errorf("FindByPointer() = %v, %v, want %v", ref, err, tt.expected)
+			}
+
+			field := findStructField(reflect.ValueOf(doc), tt.pointer[1:])
+			if !field.IsValid() || field.Interface() != tt.expected {
+				t.Errorf("findStructField() = %v, want %v", field, tt.expected)
+			}
+		})
+	}
+
+	t.Run("empty key does not match options-only tag", func(t *testing.T) {
+		if field := findStructField(reflect.ValueOf(doc), ""); field.IsValid() {
+			t.Errorf("findStructField() = %v, want invalid", field)
+		}
+	})
+
+	t.Run("tagged name hides field name", func(t *testing.T) {
+		if field := findStructField(reflect.ValueOf(doc), "Named"); field.IsValid() {
+			t.Errorf("findStructField() = %v, want invalid", field)
+		}
+	})
+}
+
+func TestValidateStructTags(t *testing.T) {
+	// Built with reflect.StructOf since go vet rejects duplicate tags in source
+	duplicated := reflect.StructOf([]reflect.StructField{
+		{Name: "ID", Type: reflect.TypeOf(""), Tag: `json:"id"`},
+		{Name: "Name", Type: reflect.TypeOf(""), Tag: `json:"name"`},
+		{Name: "LegacyID", Type: reflect.TypeOf(""), Tag: `json:"id,omitempty"`},
+	})
+
+	t.Run("duplicate tag names", func(t *testing.T) {
+		err := ValidateStructTags(duplicated)
+		if !errors.Is(err, ErrDuplicateFieldName) {
+			t.Fatalf("ValidateStructTags() = %v, want ErrDuplicateFieldName", err)
+		}
+		if msg := err.Error(); !strings.Contains(msg, `"id"`) || !strings.Contains(msg, "LegacyID") {
+			t.Errorf("error %q should name the tag and the duplicate field", msg)
+		}
+
+		// Values are checked by their type, and resolution picks the first field in declaration order
+		doc := reflect.New(duplicated).Elem()
+		doc.Field(0).SetString("first")
+		doc.Field(2).SetString("second")
+		if err := ValidateStructTags(doc.Interface()); !errors.Is(err, ErrDuplicateFieldName) {
+			t.Errorf("ValidateStructTags(value) = %v, want ErrDuplicateFieldName", err)
+		}
+		val, err := Get(doc.Interface(), "id")
+		if err != nil || val != "first" {
+			t.Errorf("Get() = %v, %v, want first", val, err)
+		}
+	})
+
+	t.Run("valid structs", func(t *testing.T) {
+		for _, v := range []any{User{}, &Profile{}, reflect.TypeOf(User{})} {
+			if err := ValidateStructTags(v); err != nil {
+				t.Errorf("ValidateStructTags(%T) = %v, want nil", v, err)
+			}
+		}
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		for _, v := range []any{nil, 1, map[string]any{}, (*int)(nil)} {
+			if err := ValidateStructTags(v); !errors.Is(err, ErrNotStruct) {
+				t.Errorf("ValidateStructTags(%T) = %v, want ErrNotStruct", v, err)
+			}
+		}
+	})
+}
+
+// EmbeddedBase is embedded by pointer in TestNilEmbeddedStruct.
+type EmbeddedBase struct {
+	BaseField string `json:"baseField"`
+}
+
+func TestNilEmbeddedStruct(t *testing.T) {
+	type withBase struct {
+		*EmbeddedBase
+		Name string `json:"name"`
+	}
+	doc := withBase{Name: "t"}
+
+	// Promoted fields are not resolved, so a nil embedded pointer cannot panic
+	if _, err := Get(doc, "baseField"); !errors.Is(err, ErrFieldNotFound) {
+		t.Errorf("Get(baseField) error = %v, want ErrFieldNotFound", err)
+	}
+	if _, err := FindByPointer(&doc, "/baseField"); !errors.Is(err, ErrFieldNotFound) {
+		t.Errorf("FindByPointer(/baseField) error = %v, want ErrFieldNotFound", err)
+	}
+
+	// The embedded struct is addressed by its type name
+	if _, err := Get(doc, "EmbeddedBase", "baseField"); !errors.Is(err, ErrNilPointer) {
+		t.Errorf("Get(EmbeddedBase/baseField) error = %v, want ErrNilPointer", err)
+	}
+	if _, err := Find(doc, "EmbeddedBase", "baseField"); !errors.Is(err, ErrNilPointer) {
+		t.Errorf("Find(EmbeddedBase/baseField) error = %v, want ErrNilPointer", err)
+	}
+	if _, err := FindByPointer(doc, "/EmbeddedBase/baseField"); !errors.Is(err, ErrNilPointer) {
+		t.Errorf("FindByPointer(/EmbeddedBase/baseField) error = %v, want ErrNilPointer", err)
+	}
+	if _, err := New(Options{}).Get(doc, "EmbeddedBase", "baseField"); !errors.Is(err, ErrNilPointer) {
+		t.Errorf("Resolver.Get(EmbeddedBase/baseField) error = %v, want ErrNilPointer", err)
+	}
+
+	doc.EmbeddedBase = &EmbeddedBase{BaseField: "base"}
+	if val, err := Get(doc, "EmbeddedBase", "baseField"); err != nil || val != "base" {
+		t.Errorf("Get(EmbeddedBase/baseField) = %v, %v, want base", val, err)
+	}
+}
@@ -0,0 +1,49 @@
+package jsonpointer
+
+import "sync"
+
+// SyncDoc guards a document with a read-write mutex so that concurrent readers
+// and writers do not race. Get and Find hold a read lock while resolving, and Set
+// holds the write lock while modifying the document.
+//
+// The lock only covers the traversal itself: values returned by Get and Find may
+// share maps, slices and pointers with the document, so callers must not modify
+// them, nor read their contents while another goroutine may call Set.
+type SyncDoc struct {
+	mu  sync.RWMutex
+	doc any
+}
+
+// NewSyncDoc returns a SyncDoc guarding doc. The document must not be accessed
+// directly while it is guarded.
+func NewSyncDoc(doc any) *SyncDoc {
+	return &SyncDoc{doc: doc}
+}
+
+// Get retrieves a value from the document using JSON Pointer string.
+func (s *SyncDoc) Get(pointer string) (any, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return GetByPointer(s.doc, pointer)
+}
+
+// Find locates a reference in the document using JSON Pointer string.
+func (s *SyncDoc) Find(pointer string) (*Reference, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return FindByPointer(s.doc, pointer)
+}
+
+// Set sets value at the location of a JSON Pointer string with the semantics of
+// SetRoot. The document's root is replaced when the update requires it, so
+// appending to a top-level slice works without a pointer.
+func (s *SyncDoc) Set(pointer string, value any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	root, err := SetRoot(s.doc, value, parseJsonPointer(pointer)...)
+	if err != nil {
+		return err
+	}
+	s.doc = root
+	return nil
+}
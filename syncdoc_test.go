@@ -0,0 +1,69 @@
+package jsonpointer
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncDoc(t *testing.T) {
+	t.Run("get, find and set", func(t *testing.T) {
+		doc := NewSyncDoc(map[string]any{"users": []any{map[string]any{"name": "Alice"}}})
+
+		assert.NoError(t, doc.Set("/users/0/name", "Bob"))
+		val, err := doc.Get("/users/0/name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Bob", val)
+
+		assert.NoError(t, doc.Set("/users/-", map[string]any{"name": "Carol"}))
+		ref, err := doc.Find("/users/1/name")
+		assert.NoError(t, err)
+		assert.Equal(t, "Carol", ref.Val)
+
+		assert.Equal(t, ErrKeyNotFound, doc.Set("/missing/name", "x"))
+	})
+
+	t.Run("top-level slice grows in place", func(t *testing.T) {
+		doc := NewSyncDoc([]any{1})
+		assert.NoError(t, doc.Set("/-", 2))
+		val, err := doc.Get("")
+		assert.NoError(t, err)
+		assert.Equal(t, []any{1, 2}, val)
+	})
+
+	t.Run("concurrent readers and writer", func(t *testing.T) {
+		doc := NewSyncDoc(map[string]any{"counter": 0, "log": []any{}})
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 1; i <= 200; i++ {
+				assert.NoError(t, doc.Set("/counter", i))
+				assert.NoError(t, doc.Set("/log/-", strconv.Itoa(i)))
+			}
+		}()
+		for r := 0; r < 4; r++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 200; i++ {
+					val, err := doc.Get("/counter")
+					assert.NoError(t, err)
+					assert.GreaterOrEqual(t, val.(int), 0)
+					_, _ = doc.Find("/log/0")
+				}
+			}()
+		}
+		wg.Wait()
+
+		val, err := doc.Get("/counter")
+		assert.NoError(t, err)
+		assert.Equal(t, 200, val)
+		log, err := doc.Get("/log")
+		assert.NoError(t, err)
+		assert.Len(t, log, 200)
+	})
+}
@@ -0,0 +1,51 @@
+package jsonpointer
+
+// Transform walks doc depth-first and returns a rebuilt copy in which every node
+// has been passed through fn. fn is called for a node before its children with
+// the node's pointer in the original document; it returns the value to keep in
+// its place, or drop to remove the node from its parent map or array. The
+// children of the returned value are then transformed in turn, so replacing a
+// subtree with a scalar skips it entirely. Dropping array elements shifts later
+// elements down, but their pointers still refer to their original positions.
+//
+// Only values that encode as JSON objects or arrays are rebuilt: maps with string
+// keys and structs with JSON-visible fields become map[string]any, and slices and
+// arrays become []any. Values that encode as JSON scalars, such as byte slices,
+// time.Time and json.Marshaler implementations, are kept as leaves. doc itself is
+// not modified. If fn drops the root, Transform returns nil.
+func Transform(doc any, fn func(pointer string, value any) (newValue any, drop bool)) any {
+	result, _ := transformNode(doc, "", fn)
+	return result
+}
+
+// transformNode applies fn to val, located at pointer, and then to its children.
+// It returns false if the node was dropped.
+func transformNode(val any, pointer string, fn func(pointer string, value any) (any, bool)) (any, bool) {
+	newVal, drop := fn(pointer, val)
+	if drop {
+		return nil, false
+	}
+
+	object, array := jsonContainerKind(newVal)
+	if object {
+		result := make(map[string]any)
+		forEachChild(newVal, func(key string, child any) bool {
+			if childVal, ok := transformNode(child, childPointer(pointer, key), fn); ok {
+				result[key] = childVal
+			}
+			return true
+		})
+		return result, true
+	}
+	if array {
+		result := make([]any, 0, numChildren(newVal))
+		forEachChild(newVal, func(key string, child any) bool {
+			if childVal, ok := transformNode(child, childPointer(pointer, key), fn); ok {
+				result = append(result, childVal)
+			}
+			return true
+		})
+		return result, true
+	}
+	return newVal, true
+}
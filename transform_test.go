@@ -0,0 +1,97 @@
+package jsonpointer
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTransform tests rebuilding a document through a callback.
+func TestTransform(t *testing.T) {
+	doc := map[string]any{
+		"user": map[string]any{
+			"name":     "Alice",
+			"password": "hunter2",
+			"nickname": nil,
+		},
+		"services": []any{
+			map[string]any{"password": map[string]any{"hash": "abc"}},
+			nil,
+			"db",
+		},
+	}
+
+	t.Run("redact passwords and drop nulls", func(t *testing.T) {
+		var pointers []string
+		result := Transform(doc, func(pointer string, value any) (any, bool) {
+			pointers = append(pointers, pointer)
+			if value == nil {
+				return nil, true
+			}
+			if path := Parse(pointer); len(path) > 0 && path[len(path)-1] == "password" {
+				return "***", false
+			}
+			return value, false
+		})
+
+		assert.Equal(t, map[string]any{
+			"user":     map[string]any{"name": "Alice", "password": "***"},
+			"services": []any{map[string]any{"password": "***"}, "db"},
+		}, result)
+
+		// Redacted subtrees are not visited, and pointers refer to the original positions
+		assert.NotContains(t, pointers, "/services/0/password/hash")
+		assert.Contains(t, pointers, "/services/2")
+
+		// The original document is unchanged
+		assert.Equal(t, "hunter2", doc["user"].(map[string]any)["password"])
+		assert.Len(t, doc["services"], 3)
+	})
+
+	t.Run("structs are rebuilt as maps", func(t *testing.T) {
+		result := Transform(&Profile{User: User{Name: "Bob"}, Location: "Tokyo"}, func(_ string, value any) (any, bool) {
+			return value, false
+		})
+		assert.Equal(t, map[string]any{
+			"user":     map[string]any{"name": "Bob", "age": 0, "Email": ""},
+			"location": "Tokyo",
+		}, result)
+	})
+
+	t.Run("identity keeps JSON scalars intact", func(t *testing.T) {
+		type event struct {
+			At      time.Time       `json:"at"`
+			Payload []byte          `json:"payload"`
+			Raw     json.RawMessage `json:"raw"`
+		}
+		at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		doc := map[string]any{
+			"event": event{At: at, Payload: []byte("hi"), Raw: json.RawMessage(`[1]`)},
+			"when":  &at,
+			"bytes": []byte("hi"),
+		}
+		result := Transform(doc, func(_ string, value any) (any, bool) {
+			return value, false
+		})
+		assert.Equal(t, map[string]any{
+			"event": map[string]any{"at": at, "payload": []byte("hi"), "raw": []any{1.0}},
+			"when":  &at,
+			"bytes": []byte("hi"),
+		}, result)
+
+		want, err := json.Marshal(doc)
+		assert.NoError(t, err)
+		got, err := json.Marshal(result)
+		assert.NoError(t, err)
+		assert.JSONEq(t, string(want), string(got))
+	})
+
+	t.Run("dropping the root", func(t *testing.T) {
+		result := Transform(doc, func(string, any) (any, bool) {
+			return nil, true
+		})
+		assert.Nil(t, result)
+	})
+}
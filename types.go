@@ -4,6 +4,7 @@
 package jsonpointer
 
 import (
+	"iter"
 	"reflect"
 	"strconv"
 )
@@ -11,6 +12,31 @@ import (
 // Path represents a JSON Pointer path as array of string tokens.
 type Path []string
 
+// Clone returns a copy of the path that does not share its backing array.
+func (p Path) Clone() Path {
+	if p == nil {
+		return nil
+	}
+	clone := make(Path, len(p))
+	copy(clone, p)
+	return clone
+}
+
+// TrimPrefix returns the remainder of the path after prefix and true if prefix is
+// equal to the path or one of its ancestors (see IsChild). Otherwise it returns the
+// path unchanged and false. The remainder shares the path's backing array.
+func (p Path) TrimPrefix(prefix Path) (Path, bool) {
+	if len(prefix) > len(p) {
+		return p, false
+	}
+	for i := range prefix {
+		if p[i] != prefix[i] {
+			return p, false
+		}
+	}
+	return p[len(prefix):], true
+}
+
 // internalToken represents a single token in a JSON Pointer path with precomputed data.
 // This is used internally for performance optimization, not exposed in the API.
 type internalToken struct {
@@ -18,11 +44,79 @@ type internalToken struct {
 	index int    // precomputed array index, -1 if not a valid array index
 }
 
+// LazyValue is implemented by values whose content is computed on demand,
+// such as lazily-loaded or remotely-fetched subtrees.
+// Traversal calls Resolve when it lands on a LazyValue, then continues with
+// the resolved value for the remaining path components.
+type LazyValue interface {
+	Resolve() (any, error)
+}
+
+// FieldAccessor is implemented by types that expose their content through
+// methods rather than exported fields, such as custom json.Unmarshaler types
+// with an internal representation. Traversal calls Field instead of using
+// struct reflection; returning false reports the field as missing.
+type FieldAccessor interface {
+	Field(name string) (any, bool)
+}
+
+// Sequence is implemented by lazily produced or streamed containers that expose
+// their children as a range-over-func sequence of key/value pairs. Traversal
+// treats a Sequence like a FieldAccessor and resolves a child by consuming the
+// sequence until the key matches, so each lookup costs O(n) in the number of
+// pairs yielded before it, and a missing key consumes the whole sequence.
+type Sequence interface {
+	Seq() iter.Seq2[string, any]
+}
+
+// Root is implemented by top-level documents that resolve their direct children
+// themselves, such as a configuration wrapper whose sections are plain maps.
+// Get, Find, GetByPointer and FindByPointer call Child for the first path
+// component only; the remaining components are resolved normally against the child.
+type Root interface {
+	Child(key string) (any, bool)
+}
+
 // Reference represents a found reference with context.
 type Reference struct {
 	Val any    `json:"val"`
 	Obj any    `json:"obj,omitempty"`
 	Key string `json:"key,omitempty"`
+
+	// path or pointer record the location the lookup that produced the reference
+	// resolved, for LocationKey. Path-based lookups keep the path, pointer-based
+	// lookups the pointer string, so neither has to format the other eagerly.
+	path    Path
+	pointer string
+
+	// root and resolver record the document the lookup started from and the
+	// Resolver it used, nil for the package-level functions, so Up and Child
+	// navigate with the same options.
+	root     any
+	resolver *Resolver
+}
+
+// Present reports whether the reference points to an existing value.
+// A nil reference, as returned by FindOptional for a missing location, is not present.
+func (r *Reference) Present() bool {
+	return r != nil
+}
+
+// IsNull reports whether the reference points to an existing JSON null value:
+// a nil interface, or a nil pointer, map or slice, which encode as null.
+// Returns false for a nil reference, which denotes absence rather than null.
+func (r *Reference) IsNull() bool {
+	return r != nil && isNull(r.Val)
+}
+
+// isNull reports whether val encodes as JSON null: a nil interface, or a nil
+// pointer, map or slice.
+func isNull(val any) bool {
+	if val == nil {
+		return true
+	}
+	rv := reflect.ValueOf(val)
+	return isNillable(rv.Kind()) && rv.IsNil()
 }
 
 // ArrayReference represents a reference to an array element.
@@ -82,6 +176,145 @@ func IsArrayEnd[T any](ref ArrayReference[T]) bool {
 	return len(ref.Obj) == ref.Key
 }
 
+// IsArrayEnd reports whether the reference points at the append position of its
+// array: Obj is a slice or array and Key is either "-" or an index equal to its length.
+func (r *Reference) IsArrayEnd() bool {
+	if r == nil || r.Obj == nil {
+		return false
+	}
+
+	objVal := reflect.ValueOf(r.Obj)
+	if objVal.Kind() != reflect.Slice && objVal.Kind() != reflect.Array {
+		return false
+	}
+	if r.Key == "-" {
+		return true
+	}
+
+	index, err := strconv.Atoi(r.Key)
+	return err == nil && strconv.Itoa(index) == r.Key && index == objVal.Len()
+}
+
+// SameLocation reports whether r and other refer to the same location: the same key
+// in the identical container. Containers are compared by identity, so maps, slices and
+// pointers match only if they share the same underlying data. References whose
+// container is a value without identity, such as a struct copy, never match.
+// Root references (nil Obj) match if their values share identity.
+func (r *Reference) SameLocation(other *Reference) bool {
+	if r == nil || other == nil || r.Key != other.Key {
+		return false
+	}
+	a, ok := r.container()
+	if !ok {
+		return false
+	}
+	b, ok := other.container()
+	return ok && a == b
+}
+
+// LocationKey returns the canonical JSON Pointer of the location the reference was
+// found at, relative to the document it was resolved against. It is stable across
+// runs and usable as a map key, for example to deduplicate wildcard matches against
+// one document. References to the document root, and references not produced by a
+// lookup of this package, return "".
+func (r *Reference) LocationKey() string {
+	if r == nil {
+		return ""
+	}
+	if r.path != nil {
+		return canonicalPointer(r.path)
+	}
+	if r.pointer == "" {
+		return ""
+	}
+	return canonicalPointer(parseJsonPointer(r.pointer))
+}
+
+// Up returns a reference to the ancestor n levels above the location of r in the
+// document r was found in; Up(0) resolves r's location again. The ancestor is looked
+// up afresh with the Resolver that produced r, if any, so the result reflects the
+// current content of the document and the same options apply. Ascending past the
+// root returns ErrNoParent, and a reference not produced by a lookup returns
+// ErrNoLocation.
+func (r *Reference) Up(n int) (*Reference, error) {
+	path, err := r.location()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, ErrInvalidPath
+	}
+	if n > len(path) {
+		return nil, ErrNoParent
+	}
+	return r.resolve(r.document(path), path[:len(path)-n])
+}
+
+// Child returns a reference to the child of r named by the unescaped path component
+// segment, resolved in doc, which must be the document r was found in. Like Up, it
+// uses the Resolver that produced r, if any.
+func (r *Reference) Child(doc any, segment string) (*Reference, error) {
+	path, err := r.location()
+	if err != nil {
+		return nil, err
+	}
+	return r.resolve(doc, append(path[:len(path):len(path)], segment))
+}
+
+// document returns the document r was found in. References to the root that
+// do not record it, such as ones constructed by the caller, are their own document.
+func (r *Reference) document(path Path) any {
+	if r.root == nil && len(path) == 0 {
+		return r.Val
+	}
+	return r.root
+}
+
+// resolve looks up path in doc with the Resolver that produced r, or with the
+// package-level functions if there is none.
+func (r *Reference) resolve(doc any, path Path) (*Reference, error) {
+	if r.resolver != nil {
+		return r.resolver.find(doc, path)
+	}
+	return Find(doc, path...)
+}
+
+// location returns the path the reference was found at.
+// References to the root have an empty path; a reference with a container but
+// no recorded location was not produced by a lookup.
+func (r *Reference) location() (Path, error) {
+	switch {
+	case r == nil:
+		return nil, ErrNoLocation
+	case r.path != nil:
+		return r.path, nil
+	case r.pointer != "":
+		return parseJsonPointer(r.pointer), nil
+	case r.Obj != nil:
+		return nil, ErrNoLocation
+	default:
+		return Path{}, nil
+	}
+}
+
+// container returns the identity of the reference's container, or of its value for
+// root references. Only maps, slices and pointers have an identity.
+func (r *Reference) container() (uintptr, bool) {
+	holder := r.Obj
+	if holder == nil {
+		holder = r.Val
+	}
+	val := reflect.ValueOf(holder)
+	kind := val.Kind()
+	if kind != reflect.Map && kind != reflect.Slice && kind != reflect.Ptr {
+		return 0, false
+	}
+	if val.IsNil() {
+		return 0, false
+	}
+	return val.Pointer(), true
+}
+
 // IsObjectReference checks if a Reference points to an object property.
 // TypeScript original code:
 // export const isObjectReference = <T = unknown>(ref: Reference): ref is ObjectReference<T> =>
@@ -1,6 +1,10 @@
 package jsonpointer
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
 	"strconv"
 	"strings"
 )
@@ -117,11 +121,15 @@ func parseJsonPointer(pointer string) Path {
 		return Path{}
 	}
 
-	// Pre-calculate number of path segments
+	// Pre-calculate number of path segments, noting whether any need unescaping
 	segmentCount := 1
+	hasEscapes := false
 	for i := 1; i < len(pointer); i++ {
-		if pointer[i] == '/' {
+		switch pointer[i] {
+		case '/':
 			segmentCount++
+		case '~':
+			hasEscapes = true
 		}
 	}
 
@@ -133,7 +141,10 @@ func parseJsonPointer(pointer string) Path {
 		if i == len(pointer) || pointer[i] == '/' {
 			// Include empty string segments (like empty segments in "/foo///")
 			segment := pointer[start:i]
-			result = append(result, unescapeComponent(segment))
+			if hasEscapes {
+				segment = unescapeComponent(segment)
+			}
+			result = append(result, segment)
 			start = i + 1
 		}
 	}
@@ -142,7 +153,8 @@ func parseJsonPointer(pointer string) Path {
 }
 
 // FormatJsonPointer escapes and formats a path slice like []string{"foo", "bar"}
-// to JSON pointer like "/foo/bar".
+// to JSON pointer like "/foo/bar". It mirrors the TypeScript original and
+// delegates to canonicalPointer.
 //
 // TypeScript Original:
 //
@@ -151,6 +163,13 @@ func parseJsonPointer(pointer string) Path {
 //	  return '/' + path.map((component) => escapeComponent(String(component))).join('/');
 //	}
 func formatJsonPointer(path Path) string {
+	return canonicalPointer(path)
+}
+
+// canonicalPointer is the single routine formatting paths as JSON Pointer strings.
+// Every pointer the package emits, in results, errors or callbacks, is produced by
+// it or built incrementally with childPointer, so escaping is identical everywhere.
+func canonicalPointer(path Path) string {
 	if IsRoot(path) {
 		return ""
 	}
@@ -161,6 +180,85 @@ func formatJsonPointer(path Path) string {
 	return "/" + strings.Join(parts, "/")
 }
 
+// childPointer returns the canonical pointer of key below the canonical pointer
+// prefix, escaping key as canonicalPointer does.
+func childPointer(prefix, key string) string {
+	return prefix + "/" + escapeComponent(key)
+}
+
+// parseWithSeparator parses a pointer whose components are separated by sep
+// instead of '/'. Escaping follows RFC 6901 with "~1" standing for sep.
+func parseWithSeparator(pointer string, sep byte) Path {
+	if sep == '/' {
+		return parseJsonPointer(pointer)
+	}
+	if pointer == "" {
+		return Path{}
+	}
+
+	result := make(Path, 0, strings.Count(pointer, string(sep)))
+	start := 1 // Skip the leading separator
+	for i := 1; i <= len(pointer); i++ {
+		if i == len(pointer) || pointer[i] == sep {
+			result = append(result, unescapeWithSeparator(pointer[start:i], sep))
+			start = i + 1
+		}
+	}
+	return result
+}
+
+// formatWithSeparator formats path as a pointer using sep instead of '/',
+// escaping "~" as "~0" and sep as "~1" in each component.
+func formatWithSeparator(path Path, sep byte) string {
+	if sep == '/' {
+		return canonicalPointer(path)
+	}
+	if IsRoot(path) {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, component := range path {
+		b.WriteByte(sep)
+		for i := 0; i < len(component); i++ {
+			switch component[i] {
+			case '~':
+				b.WriteString("~0")
+			case sep:
+				b.WriteString("~1")
+			default:
+				b.WriteByte(component[i])
+			}
+		}
+	}
+	return b.String()
+}
+
+// unescapeWithSeparator is unescapeComponent with "~1" decoding to sep.
+func unescapeWithSeparator(component string, sep byte) string {
+	if strings.IndexByte(component, '~') == -1 {
+		return component
+	}
+
+	result := make([]byte, 0, len(component))
+	for i := 0; i < len(component); i++ {
+		if component[i] == '~' && i+1 < len(component) {
+			switch component[i+1] {
+			case '0':
+				result = append(result, '~')
+				i++
+				continue
+			case '1':
+				result = append(result, sep)
+				i++
+				continue
+			}
+		}
+		result = append(result, component[i])
+	}
+	return string(result)
+}
+
 // ToPath converts a pointer (string or Path) to Path.
 // If the input is a string, it parses it as JSON pointer.
 // If the input is already a Path, it returns it as-is.
@@ -183,6 +281,88 @@ func ToPath(pointer any) Path {
 	}
 }
 
+// ToPointer converts a pointer (string, Path, []string or []int) to an escaped JSON Pointer string.
+// Strings are validated and returned in canonical form; []int is formatted as a path of
+// array indices and must not contain negative values. Any other type returns ErrPointerInvalid.
+func ToPointer(pointer any) (string, error) {
+	switch p := pointer.(type) {
+	case string:
+		if err := validatePointerString(p); err != nil {
+			return "", err
+		}
+		return formatJsonPointer(parseJsonPointer(p)), nil
+	case Path:
+		return formatJsonPointer(p), nil
+	case []string:
+		return formatJsonPointer(Path(p)), nil
+	case []int:
+		path := make(Path, len(p))
+		for i, index := range p {
+			if index < 0 {
+				return "", ErrInvalidIndex
+			}
+			path[i] = strconv.Itoa(index)
+		}
+		return formatJsonPointer(path), nil
+	default:
+		return "", ErrPointerInvalid
+	}
+}
+
+// NormalizePath converts mixed path steps into a Path of strings, the form used by
+// Get and Find. Strings are kept as-is; int, int64, uint and integral float64 steps
+// become their canonical decimal string. Negative integers return ErrInvalidIndex;
+// non-integral floats and other step types return ErrInvalidPathStep.
+func NormalizePath(steps []any) (Path, error) {
+	return stepsToPath(steps)
+}
+
+// stepsToPath converts mixed string and integer path steps into a Path.
+// Integer steps, including integral float64 values, become their decimal string
+// form; negative ones return ErrInvalidIndex. Other floats and any other step
+// type return ErrInvalidPathStep.
+func stepsToPath(steps []any) (Path, error) {
+	path := make(Path, len(steps))
+	for i, step := range steps {
+		component, err := stepToString(step)
+		if err != nil {
+			return nil, err
+		}
+		path[i] = component
+	}
+	return path, nil
+}
+
+// stepToString converts a single path step as described for stepsToPath.
+func stepToString(step any) (string, error) {
+	switch s := step.(type) {
+	case string:
+		return s, nil
+	case int:
+		if s < 0 {
+			return "", ErrInvalidIndex
+		}
+		return strconv.Itoa(s), nil
+	case int64:
+		if s < 0 {
+			return "", ErrInvalidIndex
+		}
+		return strconv.FormatInt(s, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(s), 10), nil
+	case float64:
+		if s != math.Trunc(s) || math.IsInf(s, 0) {
+			return "", ErrInvalidPathStep
+		}
+		if s < 0 {
+			return "", ErrInvalidIndex
+		}
+		return strconv.FormatFloat(s, 'f', -1, 64), nil
+	default:
+		return "", ErrInvalidPathStep
+	}
+}
+
 // IsChild returns true if parent contains child path, false otherwise.
 //
 // TypeScript Original:
@@ -299,3 +479,193 @@ func IsInteger(str string) bool {
 	}
 	return true
 }
+
+// textValue extracts the textual content of a string or byte slice value.
+// Named types whose underlying type is string or []byte are also accepted.
+func textValue(val any) (string, bool) {
+	switch v := val.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	case nil:
+		return "", false
+	}
+
+	rv := reflect.ValueOf(val)
+	switch {
+	case rv.Kind() == reflect.String:
+		return rv.String(), true
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+		return string(rv.Bytes()), true
+	default:
+		return "", false
+	}
+}
+
+// seqAccessor adapts a Sequence to FieldAccessor by scanning its pairs.
+type seqAccessor struct {
+	seq Sequence
+}
+
+// Field returns the value of the first pair whose key is name.
+func (s seqAccessor) Field(name string) (any, bool) {
+	for key, val := range s.seq.Seq() {
+		if key == name {
+			return val, true
+		}
+	}
+	return nil, false
+}
+
+// asFieldAccessor returns val as a FieldAccessor if it implements the interface
+// or is a Sequence. Nil pointers are not accessors, so traversal reports them
+// with ErrNilPointer instead of calling a method on them.
+func asFieldAccessor(val any) (FieldAccessor, bool) {
+	switch v := val.(type) {
+	case FieldAccessor:
+		return v, !isNilPointer(v)
+	case Sequence:
+		return seqAccessor{v}, !isNilPointer(v)
+	default:
+		return nil, false
+	}
+}
+
+// isNilPointer reports whether val is a nil pointer of any type.
+func isNilPointer(val any) bool {
+	rv := reflect.ValueOf(val)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+// mapKeyOf converts key to the key type of mapVal, which may be a named string
+// type. It returns false if the map's keys are not strings.
+func mapKeyOf(mapVal reflect.Value, key string) (reflect.Value, bool) {
+	keyType := mapVal.Type().Key()
+	if keyType.Kind() != reflect.String {
+		return reflect.Value{}, false
+	}
+	mapKey := reflect.ValueOf(key)
+	if keyType != mapKey.Type() {
+		mapKey = mapKey.Convert(keyType)
+	}
+	return mapKey, true
+}
+
+// isArrayEndMidPath reports whether key is the "-" array end marker applied to an
+// array before the final path component.
+func isArrayEndMidPath(current any, key string, final bool) bool {
+	if key != "-" || final {
+		return false
+	}
+	_, array := containerKind(current)
+	return array
+}
+
+// asLazy returns val as a LazyValue if it implements the interface.
+// Common decoded JSON types are ruled out first since they cannot be lazy,
+// which keeps the check cheap on hot paths. Nil pointers are values, not lazy
+// values, so Resolve is never called on them.
+func asLazy(val any) (LazyValue, bool) {
+	switch val.(type) {
+	case nil, string, float64, bool, int, map[string]any, []any:
+		return nil, false
+	}
+	lazy, ok := val.(LazyValue)
+	if !ok || isNilPointer(lazy) {
+		return nil, false
+	}
+	return lazy, true
+}
+
+// asRoot returns doc as a Root if it implements the interface.
+// Common decoded JSON types are ruled out first, as in asLazy.
+func asRoot(doc any) (Root, bool) {
+	switch doc.(type) {
+	case nil, map[string]any, []any:
+		return nil, false
+	}
+	root, ok := doc.(Root)
+	return root, ok
+}
+
+// rootChild resolves key, the first path component, through root.
+func rootChild(root Root, key string) (any, error) {
+	child, ok := root.Child(key)
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return child, nil
+}
+
+// getFromRoot resolves the first component of a non-empty path through root
+// and the rest with get.
+func getFromRoot(root Root, path Path) (any, error) {
+	child, err := rootChild(root, path[0])
+	if err != nil {
+		return nil, err
+	}
+	return get(child, path[1:])
+}
+
+// findFromRoot resolves the first component of a non-empty path through root
+// and the rest with find.
+func findFromRoot(root Root, path Path) (*Reference, error) {
+	child, err := rootChild(root, path[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 1 {
+		return &Reference{Val: child, Obj: root, Key: path[0], path: path, root: root}, nil
+	}
+	ref, err := find(child, path[1:])
+	if err != nil {
+		return nil, err
+	}
+	ref.path, ref.root = path, root
+	return ref, nil
+}
+
+// asSteppable returns val as a LazyValue to resolve before stepping into it.
+// In addition to LazyValue implementations, json.RawMessage values are decoded
+// at this point, so raw JSON is only parsed when traversal continues into it;
+// a json.RawMessage that is the final target is returned undecoded.
+func asSteppable(val any) (LazyValue, bool) {
+	if raw, ok := val.(json.RawMessage); ok {
+		return rawMessage(raw), true
+	}
+	return asLazy(val)
+}
+
+// rawMessage decodes a json.RawMessage on demand.
+type rawMessage json.RawMessage
+
+// Resolve decodes the raw JSON into the generic representation used by encoding/json.
+func (m rawMessage) Resolve() (any, error) {
+	var val any
+	if err := json.Unmarshal(m, &val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// maxLazyResolutions bounds how many chained LazyValue resolutions resolveLazy
+// performs, so a LazyValue that resolves to itself or to a cycle cannot hang traversal.
+const maxLazyResolutions = 256
+
+// resolveLazy resolves lazy until a non-lazy value is produced.
+// Resolution errors are wrapped with the JSON Pointer of the lazy value.
+func resolveLazy(lazy LazyValue, path Path) (any, error) {
+	for range maxLazyResolutions {
+		val, err := lazy.Resolve()
+		if err != nil {
+			return nil, fmt.Errorf("resolve lazy value at %q: %w", canonicalPointer(path), err)
+		}
+		next, ok := val.(LazyValue)
+		if !ok {
+			return val, nil
+		}
+		lazy = next
+	}
+	return nil, fmt.Errorf("resolve lazy value at %q: %w", canonicalPointer(path), ErrLazyResolutionLimit)
+}
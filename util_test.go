@@ -74,6 +74,39 @@ func TestFormatJsonPointer(t *testing.T) {
 	})
 }
 
+// TestParseFormatWithSeparator tests pointers using a custom separator.
+func TestParseFormatWithSeparator(t *testing.T) {
+	t.Run("round-trips with escaped separator", func(t *testing.T) {
+		path := Path{"a", "b|c", "d~e", "f/g", ""}
+		pointer := FormatWithSeparator(path, '|')
+		assert.Equal(t, "|a|b~1c|d~0e|f/g|", pointer)
+		assert.Equal(t, path, ParseWithSeparator(pointer, '|'))
+	})
+
+	t.Run("root", func(t *testing.T) {
+		assert.Equal(t, "", FormatWithSeparator(Path{}, '|'))
+		assert.Equal(t, Path{}, ParseWithSeparator("", '|'))
+		assert.Equal(t, Path{""}, ParseWithSeparator("|", '|'))
+	})
+
+	t.Run("dot separator", func(t *testing.T) {
+		assert.Equal(t, Path{"user", "e.mail"}, ParseWithSeparator(".user.e~1mail", '.'))
+		assert.Equal(t, ".user.e~1mail", FormatWithSeparator(Path{"user", "e.mail"}, '.'))
+	})
+
+	t.Run("slash matches Parse and Format", func(t *testing.T) {
+		assert.Equal(t, Parse("/a~1b/c~0d"), ParseWithSeparator("/a~1b/c~0d", '/'))
+		assert.Equal(t, Format("a/b", "c~d"), FormatWithSeparator(Path{"a/b", "c~d"}, '/'))
+	})
+
+	t.Run("escape characters are rejected", func(t *testing.T) {
+		for _, sep := range []byte{'~', '0', '1'} {
+			assert.Panics(t, func() { FormatWithSeparator(Path{"a~1"}, sep) }, string(sep))
+			assert.Panics(t, func() { ParseWithSeparator("~a~01", sep) }, string(sep))
+		}
+	})
+}
+
 // TestEscapeComponent tests path component escaping.
 // Maps to: util.escapeComponent.spec.ts
 func TestEscapeComponent(t *testing.T) {
@@ -150,6 +183,42 @@ func TestIsChild(t *testing.T) {
 	})
 }
 
+// TestPathTrimPrefix tests stripping an ancestor path.
+func TestPathTrimPrefix(t *testing.T) {
+	path := Path{"users", "0", "profile", "email"}
+
+	t.Run("strips matching prefix", func(t *testing.T) {
+		rest, ok := path.TrimPrefix(Path{"users", "0"})
+		assert.True(t, ok)
+		assert.Equal(t, Path{"profile", "email"}, rest)
+		assert.Equal(t, "/profile/email", formatJsonPointer(rest))
+	})
+
+	t.Run("equal path leaves empty remainder", func(t *testing.T) {
+		rest, ok := path.TrimPrefix(Path{"users", "0", "profile", "email"})
+		assert.True(t, ok)
+		assert.Empty(t, rest)
+	})
+
+	t.Run("empty prefix matches everything", func(t *testing.T) {
+		rest, ok := path.TrimPrefix(Path{})
+		assert.True(t, ok)
+		assert.Equal(t, path, rest)
+	})
+
+	t.Run("non-matching prefixes return path unchanged", func(t *testing.T) {
+		for _, prefix := range []Path{
+			{"users", "1"},
+			{"users", "0", "profile", "email", "x"},
+			{"user"},
+		} {
+			rest, ok := path.TrimPrefix(prefix)
+			assert.False(t, ok, prefix)
+			assert.Equal(t, path, rest, prefix)
+		}
+	})
+}
+
 // TestParent tests parent path extraction.
 // Maps to: util.parent.spec.ts
 func TestParent(t *testing.T) {
@@ -199,6 +268,51 @@ func TestToPath(t *testing.T) {
 	})
 }
 
+// TestToPointer tests converting pointer inputs to pointer strings.
+func TestToPointer(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected string
+	}{
+		{"string pointer", "/foo/a~1b", "/foo/a~1b"},
+		{"root string", "", ""},
+		{"path", Path{"foo", "a/b", "c~d"}, "/foo/a~1b/c~0d"},
+		{"empty path", Path{}, ""},
+		{"string slice", []string{"users", "0"}, "/users/0"},
+		{"int slice", []int{0, 12, 3}, "/0/12/3"},
+		{"empty int slice", []int{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := ToPointer(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+
+	t.Run("round trips through ToPath", func(t *testing.T) {
+		res, err := ToPointer(ToPath("/a~0b/0/"))
+		assert.NoError(t, err)
+		assert.Equal(t, "/a~0b/0/", res)
+	})
+
+	t.Run("invalid inputs", func(t *testing.T) {
+		_, err := ToPointer("foo")
+		assert.Equal(t, ErrPointerInvalid, err)
+
+		_, err = ToPointer("/foo~2")
+		assert.Equal(t, ErrPointerInvalid, err)
+
+		_, err = ToPointer([]int{1, -1})
+		assert.Equal(t, ErrInvalidIndex, err)
+
+		_, err = ToPointer(42)
+		assert.Equal(t, ErrPointerInvalid, err)
+	})
+}
+
 // TestIsValidIndex tests array index validation.
 func TestIsValidIndex(t *testing.T) {
 	t.Run("valid string indices", func(t *testing.T) {
@@ -310,3 +424,164 @@ func TestFastAtoi(t *testing.T) {
 		assert.Equal(t, -1, result, "fastAtoi should detect overflow and return -1")
 	})
 }
+
+func TestNormalizePath(t *testing.T) {
+	path, err := NormalizePath([]any{"users", 0, int64(12), uint(3), 4.0, "a/b", "07"})
+	assert.NoError(t, err)
+	assert.Equal(t, Path{"users", "0", "12", "3", "4", "a/b", "07"}, path)
+
+	path, err = NormalizePath(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, path)
+
+	_, err = NormalizePath([]any{"users", 1.5})
+	assert.Equal(t, ErrInvalidPathStep, err)
+	_, err = NormalizePath([]any{"users", -2})
+	assert.Equal(t, ErrInvalidIndex, err)
+	_, err = NormalizePath([]any{true})
+	assert.Equal(t, ErrInvalidPathStep, err)
+}
+
+func TestBuild(t *testing.T) {
+	pointer, err := Build("a/b", 0, "c~d")
+	assert.NoError(t, err)
+	assert.Equal(t, "/a~1b/0/c~0d", pointer)
+
+	pointer, err = Build()
+	assert.NoError(t, err)
+	assert.Equal(t, "", pointer)
+
+	pointer, err = Build("", int64(12), 3.0)
+	assert.NoError(t, err)
+	assert.Equal(t, "//12/3", pointer)
+	assert.Equal(t, Path{"", "12", "3"}, Parse(pointer))
+
+	_, err = Build("a", true)
+	assert.Equal(t, ErrInvalidPathStep, err)
+	_, err = Build("a", -1)
+	assert.Equal(t, ErrInvalidIndex, err)
+}
+
+func TestAppendPointer(t *testing.T) {
+	assert.Equal(t, "/a/b~1c", AppendPointer("/a", "b/c"))
+	assert.Equal(t, "/a/~0x", AppendPointer("/a", "~x"))
+	assert.Equal(t, "/a", AppendPointer("", "a"))
+	assert.Equal(t, "/a/", AppendPointer("/a", ""))
+	assert.Equal(t, Format("a", "b/c", "~"), AppendPointer(AppendPointer(AppendPointer("", "a"), "b/c"), "~"))
+}
+
+func TestPopPointer(t *testing.T) {
+	tests := []struct {
+		pointer string
+		parent  string
+		last    string
+		ok      bool
+	}{
+		{"/a/b", "/a", "b", true},
+		{"/a", "", "a", true},
+		{"/a/b~1c", "/a", "b/c", true},
+		{"/a~1b/c~0d", "/a~1b", "c~d", true},
+		{"/a/", "/a", "", true},
+		{"/", "", "", true},
+		{"", "", "", false},
+		{"a/b", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pointer, func(t *testing.T) {
+			parent, last, ok := PopPointer(tt.pointer)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.parent, parent)
+			assert.Equal(t, tt.last, last)
+			if ok {
+				assert.Equal(t, tt.pointer, AppendPointer(parent, last))
+			}
+		})
+	}
+}
+
+// TestCanonicalPointer tests that every emitted pointer uses the same escaping.
+func TestCanonicalPointer(t *testing.T) {
+	path := Path{"a/b", "c~d", "", "0", "~1"}
+	expected := "/a~1b/c~0d//0/~01"
+	assert.Equal(t, expected, canonicalPointer(path))
+	assert.Equal(t, expected, Format(path...))
+	assert.Equal(t, "", canonicalPointer(Path{}))
+
+	built := ""
+	for _, key := range path {
+		built = childPointer(built, key)
+	}
+	assert.Equal(t, expected, built)
+
+	doc := map[string]any{"a/b": []any{map[string]any{"c~d": 1}}, "~": "x"}
+	assertCanonical := func(pointer string) {
+		assert.Equal(t, canonicalPointer(Parse(pointer)), pointer)
+	}
+	var pointers []string
+	for _, leaf := range GetAllLeaves(doc) {
+		pointers = append(pointers, leaf.Pointer)
+	}
+	Transform(doc, func(pointer string, value any) (any, bool) {
+		pointers = append(pointers, pointer)
+		return value, false
+	})
+	assert.Contains(t, pointers, "/a~1b/0/c~0d")
+	assert.Contains(t, pointers, "/~0")
+	for _, pointer := range pointers {
+		assertCanonical(pointer)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	base := Path{"a", "b/c"}
+	rel := Path{"~d", ""}
+
+	joined := Join(base, rel)
+	assert.Equal(t, Path{"a", "b/c", "~d", ""}, joined)
+	assert.Equal(t, "/a/b~1c/~0d/", Format(joined...))
+
+	// The result does not alias its inputs
+	joined[0] = "changed"
+	assert.Equal(t, Path{"a", "b/c"}, base)
+	withCap := make(Path, 1, 4)
+	withCap[0] = "x"
+	joined = Join(withCap, Path{"y"})
+	joined[0] = "changed"
+	assert.Equal(t, "x", withCap[0])
+
+	assert.Equal(t, Path{"a", "b/c"}, Join(base, nil, Path{}))
+	assert.Empty(t, Join())
+}
+
+func TestJoinPointers(t *testing.T) {
+	tests := []struct {
+		pointers []string
+		expected string
+	}{
+		{[]string{"/a", "/b~1c"}, "/a/b~1c"},
+		{[]string{"/a~0b", "/c", "/d"}, "/a~0b/c/d"},
+		{[]string{"", "/a"}, "/a"},
+		{[]string{"/a", ""}, "/a"},
+		{[]string{"/a", "/"}, "/a/"},
+		{[]string{}, ""},
+	}
+	for _, tt := range tests {
+		joined, err := JoinPointers(tt.pointers...)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, joined)
+
+		// Joining strings matches joining their parsed paths
+		paths := make([]Path, len(tt.pointers))
+		for i, pointer := range tt.pointers {
+			paths[i] = Parse(pointer)
+		}
+		assert.Equal(t, Format(Join(paths...)...), joined)
+	}
+
+	_, err := JoinPointers("/a", "b")
+	assert.ErrorIs(t, err, ErrPointerInvalid)
+	assert.Contains(t, err.Error(), `"b"`)
+
+	_, err = JoinPointers("/a~2")
+	assert.ErrorIs(t, err, ErrPointerInvalid)
+}
@@ -1,6 +1,7 @@
 package jsonpointer
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 )
@@ -81,3 +82,25 @@ func validatePath(path any) error {
 
 	return nil
 }
+
+// validatePathStrict validates a path of mixed steps, rejecting steps that
+// stepToString cannot convert.
+func validatePathStrict(path any) error {
+	val := reflect.ValueOf(path)
+	if val.Kind() != reflect.Slice {
+		return ErrInvalidPath
+	}
+
+	length := val.Len()
+	if length > 256 {
+		return ErrPathTooLong
+	}
+
+	for i := 0; i < length; i++ {
+		step := val.Index(i).Interface()
+		if _, err := stepToString(step); err != nil {
+			return fmt.Errorf("%w at index %d: %v", ErrInvalidPathStep, i, step)
+		}
+	}
+	return nil
+}
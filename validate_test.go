@@ -142,6 +142,14 @@ func TestValidatePath(t *testing.T) {
 		assert.Equal(t, "invalid path step", err.Error())
 	})
 
+	t.Run("invalid path step - numeric values", func(t *testing.T) {
+		// Numeric steps are never valid, whether or not they could be array indices
+		for _, step := range []any{2, -1, 1.5, 2.0, int64(3)} {
+			err := ValidatePath([]any{"items", step})
+			assert.Equal(t, ErrInvalidPathStep, err, "step %v", step)
+		}
+	})
+
 	t.Run("invalid path step - slice", func(t *testing.T) {
 		// Test with []any slice containing nested slice
 		err := ValidatePath([]any{"foo", []string{"nested"}, "bar"})
@@ -180,6 +188,41 @@ func TestValidatePath(t *testing.T) {
 	})
 }
 
+// TestValidatePathStrict tests validation of paths with mixed step types.
+func TestValidatePathStrict(t *testing.T) {
+	t.Run("valid mixed steps", func(t *testing.T) {
+		assert.NoError(t, ValidatePathStrict([]any{"items", 2, "name"}))
+		assert.NoError(t, ValidatePathStrict([]any{"items", 2.0}))
+		assert.NoError(t, ValidatePathStrict([]int{0, 1, 2}))
+		assert.NoError(t, ValidatePathStrict(Path{"a", "b"}))
+	})
+
+	t.Run("non-integral float", func(t *testing.T) {
+		err := ValidatePathStrict([]any{"items", 1.5})
+		assert.ErrorIs(t, err, ErrInvalidPathStep)
+		assert.Equal(t, "invalid path step at index 1: 1.5", err.Error())
+	})
+
+	t.Run("negative integer", func(t *testing.T) {
+		err := ValidatePathStrict([]any{"items", 2, -1})
+		assert.ErrorIs(t, err, ErrInvalidPathStep)
+		assert.Equal(t, "invalid path step at index 2: -1", err.Error())
+	})
+
+	t.Run("unsupported step type", func(t *testing.T) {
+		err := ValidatePathStrict([]any{true})
+		assert.ErrorIs(t, err, ErrInvalidPathStep)
+	})
+
+	t.Run("not a slice", func(t *testing.T) {
+		assert.ErrorIs(t, ValidatePathStrict("items"), ErrInvalidPath)
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		assert.ErrorIs(t, ValidatePathStrict(make([]any, 257)), ErrPathTooLong)
+	})
+}
+
 // TestValidateEdgeCases tests edge cases and integration scenarios.
 func TestValidateEdgeCases(t *testing.T) {
 	t.Run("validate pointer with unicode characters", func(t *testing.T) {
@@ -214,3 +257,45 @@ func TestValidateEdgeCases(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+// TestIsValid tests the boolean validation helpers against the validators.
+func TestIsValid(t *testing.T) {
+	pointers := []string{
+		"",
+		"/",
+		"/foo",
+		"/foo/bar/baz",
+		"/foo~0bar/baz~1qux",
+		"/café/naïve/résumé",
+		"foo/bar",
+		"/foo~2bar",
+		"/foo~",
+		"/" + strings.Repeat("a", 1023),
+		"/" + strings.Repeat("a", 1024),
+	}
+	for _, pointer := range pointers {
+		assert.Equal(t, Validate(pointer) == nil, IsValidPointer(pointer), "pointer %q", pointer)
+	}
+	assert.True(t, IsValidPointer("/foo~0bar/baz~1qux"))
+	assert.False(t, IsValidPointer("foo/bar"))
+
+	paths := []any{
+		Path{},
+		Path{"foo", "bar", "baz"},
+		[]string{"0", "1", "2"},
+		[]any{"foo", "bar"},
+		[]any{"foo", true, "bar"},
+		[]any{"items", 2},
+		[]int{0, 1, 2},
+		"not a slice",
+		123,
+		map[string]any{"foo": "bar"},
+		make(Path, 256),
+		make(Path, 257),
+	}
+	for _, path := range paths {
+		assert.Equal(t, ValidatePath(path) == nil, IsValidPath(path), "path %v", path)
+	}
+	assert.True(t, IsValidPath(Path{"foo", "0"}))
+	assert.False(t, IsValidPath([]int{0}))
+}
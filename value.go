@@ -0,0 +1,80 @@
+package jsonpointer
+
+import "reflect"
+
+// GetValue retrieves the value at path within root, operating on reflect.Value
+// throughout instead of boxing each intermediate value in an interface.
+// Steps are strings or integers as accepted by FindWithPath. Elements of
+// addressable roots, such as reflect.ValueOf(&doc).Elem(), remain addressable
+// and can be set directly. Struct fields are matched like Set: by json tag name,
+// or by Go field name when untagged. LazyValue and FieldAccessor are not consulted.
+func GetValue(root reflect.Value, path ...any) (reflect.Value, error) {
+	val, _, _, err := FindValue(root, path...)
+	return val, err
+}
+
+// FindValue locates the value at path within root like GetValue, and also returns
+// its container and the final key. For an empty path, the container is invalid
+// and the key is empty.
+func FindValue(root reflect.Value, path ...any) (val, obj reflect.Value, key string, err error) {
+	keys, err := stepsToPath(path)
+	if err != nil {
+		return reflect.Value{}, reflect.Value{}, "", err
+	}
+
+	current := root
+	for i, k := range keys {
+		obj, key = current, k
+		current, err = valueStep(current, k, i == len(keys)-1)
+		if err != nil {
+			return reflect.Value{}, reflect.Value{}, "", err
+		}
+	}
+	return current, obj, key, nil
+}
+
+// valueStep resolves a single path component against the reflect.Value current.
+func valueStep(current reflect.Value, key string, final bool) (reflect.Value, error) {
+	for current.Kind() == reflect.Ptr || current.Kind() == reflect.Interface {
+		if current.IsNil() {
+			return reflect.Value{}, ErrNilPointer
+		}
+		current = current.Elem()
+	}
+
+	switch current.Kind() {
+	case reflect.Slice, reflect.Array:
+		if key == "-" && !final {
+			return reflect.Value{}, ErrArrayEndNotFinal
+		}
+		index, err := arrayIndex(key, current.Len())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return current.Index(index), nil
+
+	case reflect.Map:
+		mapKey, ok := mapKeyOf(current, key)
+		if !ok {
+			return reflect.Value{}, ErrNotFound
+		}
+		if mapVal := current.MapIndex(mapKey); mapVal.IsValid() {
+			return mapVal, nil
+		}
+		return reflect.Value{}, ErrKeyNotFound
+
+	case reflect.Struct:
+		if index, ok := getStructFields(current.Type())[key]; ok {
+			return current.Field(index), nil
+		}
+		return reflect.Value{}, ErrFieldNotFound
+
+	case reflect.Invalid, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.Chan, reflect.Func, reflect.Interface, reflect.Ptr, reflect.String, reflect.UnsafePointer:
+		// Handle all other reflect.Kind types not supported for JSON Pointer traversal
+		return reflect.Value{}, ErrNotFound
+	}
+	return reflect.Value{}, ErrNotFound
+}
@@ -0,0 +1,83 @@
+package jsonpointer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetValue tests resolving reflect.Value roots.
+func TestGetValue(t *testing.T) {
+	type team struct {
+		Members [2]User          `json:"members"`
+		Lead    *Profile         `json:"lead"`
+		Meta    map[string]any   `json:"meta"`
+		Scores  map[string][]int `json:"scores"`
+	}
+	doc := team{
+		Members: [2]User{{Name: "Alice"}, {Name: "Bob", Age: 25}},
+		Lead:    &Profile{User: User{Name: "Carol"}, Location: "Oslo"},
+		Meta:    map[string]any{"tags": []any{"x"}},
+		Scores:  map[string][]int{"q1": {7, 9}},
+	}
+	root := reflect.ValueOf(&doc).Elem()
+
+	t.Run("struct field", func(t *testing.T) {
+		val, err := GetValue(root, "lead", "user", "name")
+		assert.NoError(t, err)
+		assert.Equal(t, reflect.String, val.Kind())
+		assert.Equal(t, "Carol", val.String())
+	})
+
+	t.Run("array element", func(t *testing.T) {
+		val, err := GetValue(root, "members", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, reflect.TypeOf(User{}), val.Type())
+		assert.Equal(t, 25, val.Interface().(User).Age)
+
+		// Elements of an addressable root can be set in place
+		val, err = GetValue(root, "members", "1", "Email")
+		assert.NoError(t, err)
+		assert.True(t, val.CanSet())
+		val.SetString("bob@example.com")
+		assert.Equal(t, "bob@example.com", doc.Members[1].Email)
+	})
+
+	t.Run("maps and interfaces", func(t *testing.T) {
+		val, err := GetValue(root, "meta", "tags", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "x", val.Interface())
+
+		val, obj, key, err := FindValue(root, "scores", "q1", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), val.Int())
+		assert.Equal(t, []int{7, 9}, obj.Interface())
+		assert.Equal(t, "1", key)
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		val, obj, key, err := FindValue(root)
+		assert.NoError(t, err)
+		assert.Equal(t, root, val)
+		assert.False(t, obj.IsValid())
+		assert.Empty(t, key)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := GetValue(root, "members", 2)
+		assert.Equal(t, ErrIndexOutOfBounds, err)
+		_, err = GetValue(root, "members", "-", "name")
+		assert.Equal(t, ErrArrayEndNotFinal, err)
+		_, err = GetValue(root, "meta", "missing")
+		assert.Equal(t, ErrKeyNotFound, err)
+		_, err = GetValue(root, "nope")
+		assert.Equal(t, ErrFieldNotFound, err)
+		_, err = GetValue(root, "members", -1)
+		assert.Equal(t, ErrInvalidIndex, err)
+		_, err = GetValue(root, "lead", "location", "x")
+		assert.Equal(t, ErrNotFound, err)
+		_, err = GetValue(reflect.ValueOf(team{}), "lead", "user")
+		assert.Equal(t, ErrNilPointer, err)
+	})
+}
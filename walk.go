@@ -0,0 +1,239 @@
+package jsonpointer
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+)
+
+// Visitor receives structural events from WalkEvents, similar to a SAX parser.
+// Every EnterObject or EnterArray is matched by a LeaveObject or LeaveArray with
+// the same pointer and key once all children have been visited. The root has
+// pointer "" and key "". Returning an error from any callback stops the walk.
+type Visitor interface {
+	// EnterObject is called before the members of a map or struct.
+	EnterObject(pointer, key string) error
+	// LeaveObject is called after the members of a map or struct.
+	LeaveObject(pointer, key string) error
+	// EnterArray is called before the elements of a slice or array.
+	EnterArray(pointer, key string) error
+	// LeaveArray is called after the elements of a slice or array.
+	LeaveArray(pointer, key string) error
+	// Leaf is called for scalars and null values, including nil maps and slices.
+	Leaf(pointer string, value any) error
+}
+
+// WalkEvents walks doc depth-first in canonical order (map keys sorted, array
+// elements by index and struct fields in declaration order) and reports container
+// boundaries and leaves to visitor. It returns the first error returned by visitor.
+func WalkEvents(doc any, visitor Visitor) error {
+	return walkEvents(doc, "", "", visitor)
+}
+
+// walkEvents reports the events for val, located at pointer under key.
+func walkEvents(val any, pointer, key string, visitor Visitor) error {
+	object, array := containerKind(val)
+	if !object && !array {
+		return visitor.Leaf(pointer, val)
+	}
+
+	var err error
+	if object {
+		err = visitor.EnterObject(pointer, key)
+	} else {
+		err = visitor.EnterArray(pointer, key)
+	}
+	if err != nil {
+		return err
+	}
+
+	forEachChild(val, func(childKey string, child any) bool {
+		err = walkEvents(child, childPointer(pointer, childKey), childKey, visitor)
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if object {
+		return visitor.LeaveObject(pointer, key)
+	}
+	return visitor.LeaveArray(pointer, key)
+}
+
+// containerKind reports whether val is an object (map with string keys or struct)
+// or an array (slice or array) whose children forEachChild visits.
+// Nil pointers, maps and slices are neither, as they encode as JSON null.
+func containerKind(val any) (object, array bool) {
+	switch v := val.(type) {
+	case nil:
+		return false, false
+	case json.RawMessage:
+		decoded, err := rawMessage(v).Resolve()
+		if err != nil {
+			return false, false
+		}
+		return containerKind(decoded)
+	case map[string]any:
+		return v != nil, false
+	case []any:
+		return false, v != nil
+	}
+
+	objVal := reflect.ValueOf(val)
+	for objVal.Kind() == reflect.Ptr {
+		if objVal.IsNil() {
+			return false, false
+		}
+		objVal = objVal.Elem()
+	}
+
+	kind := objVal.Kind()
+	if kind == reflect.Struct {
+		return true, false
+	}
+	if kind == reflect.Map && objVal.Type().Key().Kind() == reflect.String {
+		return !objVal.IsNil(), false
+	}
+	if kind == reflect.Slice {
+		return false, !objVal.IsNil()
+	}
+	return false, kind == reflect.Array
+}
+
+// jsonContainerKind is like containerKind, but only reports values that encode as
+// JSON objects or arrays. Byte slices, which encode as base64 strings, values
+// implementing json.Marshaler or encoding.TextMarshaler, and structs without
+// JSON-visible fields, such as time.Time, are neither.
+func jsonContainerKind(val any) (object, array bool) {
+	switch val.(type) {
+	case nil, json.RawMessage, map[string]any, []any:
+		return containerKind(val)
+	case json.Marshaler, encoding.TextMarshaler:
+		return false, false
+	}
+
+	object, array = containerKind(val)
+	if !object && !array {
+		return false, false
+	}
+	t := reflect.TypeOf(val)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		return len(getStructFields(t)) > 0, false
+	case reflect.Slice:
+		return false, t.Elem().Kind() != reflect.Uint8
+	default:
+		return object, array
+	}
+}
+
+// WalkDepth calls fn for every leaf of doc like GetAllLeaves, but stops descending
+// once maxDepth path components are reached: nodes at that depth are passed to fn
+// as if they were leaves, with their whole subtree as the value. A maxDepth of 0
+// passes just the root; a negative maxDepth walks the entire document.
+// It returns the first error returned by fn.
+func WalkDepth(doc any, maxDepth int, fn func(pointer string, value any) error) error {
+	return walkDepth(doc, "", maxDepth, fn)
+}
+
+// walkDepth walks val, located at pointer, with depth levels remaining.
+func walkDepth(val any, pointer string, depth int, fn func(pointer string, value any) error) error {
+	if depth == 0 {
+		return fn(pointer, val)
+	}
+
+	var err error
+	hasChildren := false
+	forEachChild(val, func(key string, child any) bool {
+		hasChildren = true
+		err = walkDepth(child, childPointer(pointer, key), depth-1, fn)
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	if !hasChildren {
+		return fn(pointer, val)
+	}
+	return nil
+}
+
+// WalkStruct calls fn for every exported field of the struct v, which may also be
+// a pointer to a struct, recursing into nested structs. Unlike the value walks it
+// is driven by the Go type rather than the runtime data: fields are visited even
+// when zero-valued, nil pointers are followed through a zero value of their element
+// type, and slices, arrays and maps of structs are described once, under a "*"
+// segment, by a zero value of their element type. Fields are named by their json
+// tags as in traversal, and visited in declaration order. Interface fields are not
+// descended into, and a struct type nested within itself is visited only once per
+// branch. It returns ErrNotStruct if v is not a struct, and otherwise the first
+// error returned by fn.
+func WalkStruct(v any, fn func(pointer string, field reflect.StructField, value reflect.Value) error) error {
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			val = reflect.Zero(val.Type().Elem())
+		} else {
+			val = val.Elem()
+		}
+	}
+	if val.Kind() != reflect.Struct {
+		return ErrNotStruct
+	}
+	return walkStruct(val, "", make(map[reflect.Type]bool), fn)
+}
+
+// walkStruct visits the fields of the struct val, located at pointer. visiting
+// holds the struct types on the current branch to stop recursive types.
+func walkStruct(val reflect.Value, pointer string, visiting map[reflect.Type]bool, fn func(string, reflect.StructField, reflect.Value) error) error {
+	t := val.Type()
+	if visiting[t] {
+		return nil
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	fields := getStructFields(t)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := getFieldName(field, "json")
+		if index, ok := fields[name]; !ok || index != i {
+			continue // Unexported, ignored or shadowed
+		}
+
+		fieldPointer := childPointer(pointer, name)
+		value := val.Field(i)
+		if err := fn(fieldPointer, field, value); err != nil {
+			return err
+		}
+		if err := walkStructType(value, fieldPointer, visiting, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkStructType descends into the structs reachable from the type of val,
+// located at pointer.
+func walkStructType(val reflect.Value, pointer string, visiting map[reflect.Type]bool, fn func(string, reflect.StructField, reflect.Value) error) error {
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			val = reflect.Zero(val.Type().Elem())
+		} else {
+			val = val.Elem()
+		}
+	}
+
+	kind := val.Kind()
+	if kind == reflect.Struct {
+		return walkStruct(val, pointer, visiting, fn)
+	}
+	if kind == reflect.Slice || kind == reflect.Array || kind == reflect.Map {
+		return walkStructType(reflect.Zero(val.Type().Elem()), childPointer(pointer, wildcardAny), visiting, fn)
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+package jsonpointer
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rebuilder reconstructs a document from WalkEvents and records the events.
+type rebuilder struct {
+	frames []rebuildFrame
+	root   any
+	events []string
+}
+
+type rebuildFrame struct {
+	key    string
+	object map[string]any
+	array  []any
+}
+
+func (b *rebuilder) EnterObject(pointer, key string) error {
+	b.events = append(b.events, "{ "+pointer)
+	b.frames = append(b.frames, rebuildFrame{key: key, object: map[string]any{}})
+	return nil
+}
+
+func (b *rebuilder) LeaveObject(pointer, key string) error {
+	b.events = append(b.events, "} "+pointer)
+	return b.pop()
+}
+
+func (b *rebuilder) EnterArray(pointer, key string) error {
+	b.events = append(b.events, "[ "+pointer)
+	b.frames = append(b.frames, rebuildFrame{key: key, array: []any{}})
+	return nil
+}
+
+func (b *rebuilder) LeaveArray(pointer, key string) error {
+	b.events = append(b.events, "] "+pointer)
+	return b.pop()
+}
+
+func (b *rebuilder) Leaf(pointer string, value any) error {
+	b.events = append(b.events, "= "+pointer)
+	key := ""
+	if path := Parse(pointer); len(path) > 0 {
+		key = path[len(path)-1]
+	}
+	b.add(key, value)
+	return nil
+}
+
+func (b *rebuilder) pop() error {
+	frame := b.frames[len(b.frames)-1]
+	b.frames = b.frames[:len(b.frames)-1]
+	if frame.object != nil {
+		b.add(frame.key, frame.object)
+	} else {
+		b.add(frame.key, frame.array)
+	}
+	return nil
+}
+
+func (b *rebuilder) add(key string, value any) {
+	if len(b.frames) == 0 {
+		b.root = value
+		return
+	}
+	parent := &b.frames[len(b.frames)-1]
+	if parent.object != nil {
+		parent.object[key] = value
+	} else {
+		parent.array = append(parent.array, value)
+	}
+}
+
+var errStopWalk = errors.New("stop walk")
+
+// stoppingVisitor fails on the first leaf.
+type stoppingVisitor struct{ rebuilder }
+
+func (v *stoppingVisitor) Leaf(pointer string, value any) error {
+	_ = v.rebuilder.Leaf(pointer, value)
+	return errStopWalk
+}
+
+// TestWalkEvents tests structural walking with container boundaries.
+func TestWalkEvents(t *testing.T) {
+	t.Run("reconstructs document", func(t *testing.T) {
+		doc := map[string]any{
+			"name":  "Alice",
+			"a/b":   nil,
+			"tags":  []any{"x", []any{}, map[string]any{"k": 1.5}},
+			"empty": map[string]any{},
+		}
+
+		b := &rebuilder{}
+		assert.NoError(t, WalkEvents(doc, b))
+		assert.Equal(t, doc, b.root)
+		assert.Equal(t, []string{
+			"{ ",
+			"= /a~1b",
+			"{ /empty",
+			"} /empty",
+			"= /name",
+			"[ /tags",
+			"= /tags/0",
+			"[ /tags/1",
+			"] /tags/1",
+			"{ /tags/2",
+			"= /tags/2/k",
+			"} /tags/2",
+			"] /tags",
+			"} ",
+		}, b.events)
+	})
+
+	t.Run("structs are objects in field order", func(t *testing.T) {
+		b := &rebuilder{}
+		assert.NoError(t, WalkEvents(&Profile{User: User{Name: "Bob"}, Location: "Tokyo"}, b))
+		assert.Equal(t, map[string]any{
+			"user":     map[string]any{"name": "Bob", "age": 0, "Email": ""},
+			"location": "Tokyo",
+		}, b.root)
+		assert.Equal(t, "{ /user", b.events[1])
+	})
+
+	t.Run("nil containers and scalars are leaves", func(t *testing.T) {
+		for _, doc := range []any{"text", 42, nil, (*User)(nil), map[string]any(nil), []any(nil)} {
+			b := &rebuilder{}
+			assert.NoError(t, WalkEvents(doc, b))
+			assert.Equal(t, []string{"= "}, b.events)
+		}
+	})
+
+	t.Run("visitor error stops walk", func(t *testing.T) {
+		v := &stoppingVisitor{}
+		err := WalkEvents(map[string]any{"a": 1, "b": []any{2}}, v)
+		assert.Equal(t, errStopWalk, err)
+		assert.Equal(t, []string{"{ ", "= /a"}, v.events)
+	})
+}
+
+// TestWalkDepth tests walking down to a maximum depth.
+func TestWalkDepth(t *testing.T) {
+	doc := map[string]any{
+		"a": map[string]any{"b": map[string]any{"c": 1}},
+		"d": []any{1, 2},
+		"e": "leaf",
+	}
+
+	collect := func(maxDepth int) []Leaf {
+		var leaves []Leaf
+		err := WalkDepth(doc, maxDepth, func(pointer string, value any) error {
+			leaves = append(leaves, Leaf{Pointer: pointer, Value: value})
+			return nil
+		})
+		assert.NoError(t, err)
+		return leaves
+	}
+
+	t.Run("depth 0 emits the root", func(t *testing.T) {
+		assert.Equal(t, []Leaf{{Pointer: "", Value: doc}}, collect(0))
+	})
+
+	t.Run("depth 1 emits top-level subtrees", func(t *testing.T) {
+		assert.Equal(t, []Leaf{
+			{Pointer: "/a", Value: doc["a"]},
+			{Pointer: "/d", Value: doc["d"]},
+			{Pointer: "/e", Value: "leaf"},
+		}, collect(1))
+	})
+
+	t.Run("depth 2 stops below the boundary", func(t *testing.T) {
+		assert.Equal(t, []Leaf{
+			{Pointer: "/a/b", Value: map[string]any{"c": 1}},
+			{Pointer: "/d/0", Value: 1},
+			{Pointer: "/d/1", Value: 2},
+			{Pointer: "/e", Value: "leaf"},
+		}, collect(2))
+	})
+
+	t.Run("negative depth walks everything", func(t *testing.T) {
+		assert.Equal(t, GetAllLeaves(doc), collect(-1))
+	})
+
+	t.Run("callback error stops walk", func(t *testing.T) {
+		calls := 0
+		err := WalkDepth(doc, 1, func(string, any) error {
+			calls++
+			return errStopWalk
+		})
+		assert.Equal(t, errStopWalk, err)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+// walkUser mirrors the BenchUser type used by the benchmarks.
+type walkUser struct {
+	Name    string               `json:"name"`
+	Age     int                  `json:"age"`
+	Profile walkProfile          `json:"profile"`
+	Hobbies []string             `json:"hobbies"`
+	Scores  []int                `json:"scores"`
+	Friends []*walkUser          `json:"friends"`
+	Groups  map[string]walkGroup `json:"groups,omitempty"`
+	Skipped string               `json:"-"`
+}
+
+type walkProfile struct {
+	Email    string        `json:"email"`
+	Settings *walkSettings `json:"settings"`
+}
+
+type walkSettings struct {
+	Theme         string `json:"theme"`
+	Notifications bool   `json:"notifications"`
+}
+
+type walkGroup struct {
+	Title string `json:"title"`
+}
+
+func TestWalkStruct(t *testing.T) {
+	type visit struct {
+		pointer string
+		tag     string
+		kind    reflect.Kind
+	}
+	collect := func(v any) []visit {
+		var visits []visit
+		err := WalkStruct(v, func(pointer string, field reflect.StructField, value reflect.Value) error {
+			visits = append(visits, visit{pointer, field.Tag.Get("json"), value.Kind()})
+			return nil
+		})
+		assert.NoError(t, err)
+		return visits
+	}
+
+	t.Run("extracts tags of all nested fields", func(t *testing.T) {
+		expected := []visit{
+			{"/name", "name", reflect.String},
+			{"/age", "age", reflect.Int},
+			{"/profile", "profile", reflect.Struct},
+			{"/profile/email", "email", reflect.String},
+			{"/profile/settings", "settings", reflect.Ptr},
+			{"/profile/settings/theme", "theme", reflect.String},
+			{"/profile/settings/notifications", "notifications", reflect.Bool},
+			{"/hobbies", "hobbies", reflect.Slice},
+			{"/scores", "scores", reflect.Slice},
+			{"/friends", "friends", reflect.Slice},
+			{"/groups", "groups,omitempty", reflect.Map},
+			{"/groups/*/title", "title", reflect.String},
+		}
+		assert.Equal(t, expected, collect(walkUser{}))
+		assert.Equal(t, expected, collect(&walkUser{}))
+		assert.Equal(t, expected, collect((*walkUser)(nil)))
+	})
+
+	t.Run("values come from the data", func(t *testing.T) {
+		user := &walkUser{Name: "Alice", Profile: walkProfile{Settings: &walkSettings{Theme: "dark"}}}
+		values := map[string]any{}
+		err := WalkStruct(user, func(pointer string, _ reflect.StructField, value reflect.Value) error {
+			values[pointer] = value.Interface()
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", values["/name"])
+		assert.Equal(t, "dark", values["/profile/settings/theme"])
+		assert.Equal(t, "", values["/groups/*/title"])
+	})
+
+	t.Run("recursive types are visited once per branch", func(t *testing.T) {
+		type node struct {
+			Value    int     `json:"value"`
+			Children []*node `json:"children"`
+		}
+		assert.Equal(t, []visit{
+			{"/value", "value", reflect.Int},
+			{"/children", "children", reflect.Slice},
+		}, collect(node{}))
+	})
+
+	t.Run("callback error stops walk", func(t *testing.T) {
+		calls := 0
+		err := WalkStruct(walkUser{}, func(string, reflect.StructField, reflect.Value) error {
+			calls++
+			return errStopWalk
+		})
+		assert.Equal(t, errStopWalk, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		noop := func(string, reflect.StructField, reflect.Value) error { return nil }
+		assert.ErrorIs(t, WalkStruct(map[string]any{}, noop), ErrNotStruct)
+		assert.ErrorIs(t, WalkStruct(nil, noop), ErrNotStruct)
+	})
+}
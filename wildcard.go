@@ -0,0 +1,239 @@
+package jsonpointer
+
+import (
+	"slices"
+	"strings"
+)
+
+// Wildcard pattern segments.
+// "*" matches any single child and "**" matches any number of levels, including none.
+const (
+	wildcardAny       = "*"
+	wildcardRecursive = "**"
+)
+
+// patternSegment is a parsed segment of a wildcard pattern.
+// Wildcard segments hold "*" or "**" in wildcard; literal segments hold the unescaped key.
+type patternSegment struct {
+	key      string
+	wildcard string
+}
+
+// GetAll returns references to every node matching a wildcard pattern.
+// A pattern is a JSON Pointer whose segments may be "*" (any direct child)
+// or "**" (any descendant at any depth, including the current node).
+// Other segments are literal keys, escaped as in JSON Pointer ("~0" for "~",
+// "~1" for "/"); patterns additionally accept "~*" for a literal "*", so the
+// segments "~*" and "~*~*" match keys named "*" and "**".
+// Matches are returned in canonical order: map keys sorted, array elements by index
+// and struct fields in declaration order. Patterns using "**" more than once may
+// report the same node more than once.
+func GetAll(doc any, pattern string) ([]*Reference, error) {
+	segments, err := parsePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return collectMatches(doc, segments), nil
+}
+
+// CompiledPattern is a validated wildcard pattern parsed once for repeated use.
+// It is immutable and safe for concurrent use.
+type CompiledPattern struct {
+	pattern  string
+	segments []patternSegment
+}
+
+// CompilePattern validates and parses a wildcard pattern, as accepted by GetAll,
+// for matching against many documents.
+func CompilePattern(pattern string) (*CompiledPattern, error) {
+	segments, err := parsePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &CompiledPattern{pattern: pattern, segments: segments}, nil
+}
+
+// String returns the pattern string the pattern was compiled from.
+func (p *CompiledPattern) String() string {
+	return p.pattern
+}
+
+// Match returns references to every node of doc matching the pattern,
+// in the same order as GetAll.
+func (p *CompiledPattern) Match(doc any) []*Reference {
+	return collectMatches(doc, p.segments)
+}
+
+// collectMatches returns references to every node of doc matching segments.
+func collectMatches(doc any, segments []patternSegment) []*Reference {
+	var refs []*Reference
+	matchPattern(doc, nil, "", nil, segments, func(val, obj any, key string, path Path) bool {
+		refs = append(refs, &Reference{Val: val, Obj: obj, Key: key, path: slices.Clone(path), root: doc})
+		return true
+	})
+	return refs
+}
+
+// collectMatchesLimit is like collectMatches but stops once more than limit nodes
+// match. It then returns the first limit references if truncate is set, and
+// ErrTooManyMatches otherwise. A limit of zero means unlimited.
+func collectMatchesLimit(doc any, segments []patternSegment, limit int, truncate bool) ([]*Reference, error) {
+	if limit <= 0 {
+		return collectMatches(doc, segments), nil
+	}
+
+	var refs []*Reference
+	exceeded := false
+	matchPattern(doc, nil, "", nil, segments, func(val, obj any, key string, path Path) bool {
+		if len(refs) == limit {
+			exceeded = true
+			return false
+		}
+		refs = append(refs, &Reference{Val: val, Obj: obj, Key: key, path: slices.Clone(path), root: doc})
+		return true
+	})
+	if exceeded && !truncate {
+		return nil, ErrTooManyMatches
+	}
+	return refs, nil
+}
+
+// CountMatches returns the number of nodes matching a wildcard pattern.
+// It uses the same matching rules as GetAll without collecting references.
+func CountMatches(doc any, pattern string) (int, error) {
+	segments, err := parsePattern(pattern)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	matchPattern(doc, nil, "", nil, segments, func(any, any, string, Path) bool {
+		count++
+		return true
+	})
+	return count, nil
+}
+
+// FindOne returns a reference to the single node matching a wildcard pattern.
+// It uses the same matching rules as GetAll but returns ErrNotFound if no node
+// matches and ErrMultipleMatches if more than one does.
+func FindOne(doc any, pattern string) (*Reference, error) {
+	segments, err := parsePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var ref *Reference
+	count := 0
+	matchPattern(doc, nil, "", nil, segments, func(val, obj any, key string, path Path) bool {
+		count++
+		if count > 1 {
+			return false
+		}
+		ref = &Reference{Val: val, Obj: obj, Key: key, path: slices.Clone(path), root: doc}
+		return true
+	})
+
+	switch count {
+	case 0:
+		return nil, ErrNotFound
+	case 1:
+		return ref, nil
+	default:
+		return nil, ErrMultipleMatches
+	}
+}
+
+// parsePattern splits a wildcard pattern into segments, validating it like a
+// JSON Pointer string except that "~*" is accepted as an escaped "*".
+func parsePattern(pattern string) ([]patternSegment, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	if pattern[0] != '/' {
+		return nil, ErrPointerInvalid
+	}
+	if len(pattern) > 1024 {
+		return nil, ErrPointerTooLong
+	}
+
+	raw := strings.Split(pattern[1:], "/")
+	segments := make([]patternSegment, len(raw))
+	for i, segment := range raw {
+		if segment == wildcardAny || segment == wildcardRecursive {
+			segments[i].wildcard = segment
+			continue
+		}
+		key, err := unescapePatternSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		segments[i].key = key
+	}
+	return segments, nil
+}
+
+// unescapePatternSegment unescapes a literal pattern segment: "~0" becomes "~",
+// "~1" becomes "/" and "~*" becomes "*". Any other use of "~" is invalid.
+func unescapePatternSegment(segment string) (string, error) {
+	if strings.IndexByte(segment, '~') == -1 {
+		return segment, nil
+	}
+
+	result := make([]byte, 0, len(segment))
+	for i := 0; i < len(segment); i++ {
+		if segment[i] != '~' {
+			result = append(result, segment[i])
+			continue
+		}
+		if i+1 >= len(segment) {
+			return "", ErrPointerInvalid
+		}
+		switch segment[i+1] {
+		case '0':
+			result = append(result, '~')
+		case '1':
+			result = append(result, '/')
+		case '*':
+			result = append(result, '*')
+		default:
+			return "", ErrPointerInvalid
+		}
+		i++ // Skip the escaped character
+	}
+	return string(result), nil
+}
+
+// matchPattern evaluates pattern against val, which was reached via obj and key
+// at path, calling fn with the value, container, key and path of each match.
+// The path passed to fn shares storage with later matches, so fn must copy it to
+// retain it. Literal segments that cannot be resolved simply produce no match.
+// Returns false if fn stopped the evaluation.
+func matchPattern(val, obj any, key string, path Path, pattern []patternSegment, fn func(val, obj any, key string, path Path) bool) bool {
+	if len(pattern) == 0 {
+		return fn(val, obj, key, path)
+	}
+
+	segment, rest := pattern[0], pattern[1:]
+	switch segment.wildcard {
+	case wildcardAny:
+		return forEachChild(val, func(childKey string, child any) bool {
+			return matchPattern(child, val, childKey, append(path, childKey), rest, fn)
+		})
+
+	case wildcardRecursive:
+		if !matchPattern(val, obj, key, path, rest, fn) {
+			return false
+		}
+		return forEachChild(val, func(childKey string, child any) bool {
+			return matchPattern(child, val, childKey, append(path, childKey), pattern, fn)
+		})
+
+	default:
+		child, err := get(val, Path{segment.key})
+		if err != nil {
+			return true
+		}
+		return matchPattern(child, val, segment.key, append(path, segment.key), rest, fn)
+	}
+}
@@ -0,0 +1,234 @@
+package jsonpointer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func wildcardDoc() map[string]any {
+	return map[string]any{
+		"users": []any{
+			map[string]any{"name": "Alice", "tags": []any{"admin"}},
+			map[string]any{"name": "Bob", "tags": []any{}},
+			map[string]any{"name": "Carol"},
+		},
+		"meta": map[string]any{"name": "root", "version": 2},
+	}
+}
+
+// TestGetAll tests wildcard pattern matching.
+func TestGetAll(t *testing.T) {
+	doc := wildcardDoc()
+
+	t.Run("single-level wildcard", func(t *testing.T) {
+		refs, err := GetAll(doc, "/users/*/name")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 3)
+		assert.Equal(t, "Alice", refs[0].Val)
+		assert.Equal(t, "Bob", refs[1].Val)
+		assert.Equal(t, "Carol", refs[2].Val)
+		assert.Equal(t, "name", refs[0].Key)
+	})
+
+	t.Run("wildcard references keep container and key", func(t *testing.T) {
+		refs, err := GetAll(doc, "/users/*")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 3)
+		assert.Equal(t, "2", refs[2].Key)
+		assert.Equal(t, doc["users"], refs[2].Obj)
+	})
+
+	t.Run("map children in sorted key order", func(t *testing.T) {
+		refs, err := GetAll(doc, "/meta/*")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 2)
+		assert.Equal(t, "name", refs[0].Key)
+		assert.Equal(t, "version", refs[1].Key)
+	})
+
+	t.Run("recursive wildcard", func(t *testing.T) {
+		refs, err := GetAll(doc, "/**/name")
+		assert.NoError(t, err)
+		vals := make([]any, len(refs))
+		for i, ref := range refs {
+			vals[i] = ref.Val
+		}
+		assert.Equal(t, []any{"root", "Alice", "Bob", "Carol"}, vals)
+	})
+
+	t.Run("literal segments without match are skipped", func(t *testing.T) {
+		refs, err := GetAll(doc, "/users/*/tags/0")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 1)
+		assert.Equal(t, "admin", refs[0].Val)
+	})
+
+	t.Run("root pattern", func(t *testing.T) {
+		refs, err := GetAll(doc, "")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 1)
+		assert.Equal(t, doc, refs[0].Val)
+	})
+
+	t.Run("struct fields", func(t *testing.T) {
+		refs, err := GetAll([]User{{Name: "Alice", Age: 30}}, "/*/*")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 3)
+		assert.Equal(t, "name", refs[0].Key)
+		assert.Equal(t, "age", refs[1].Key)
+		assert.Equal(t, "Email", refs[2].Key)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := GetAll(doc, "users/*")
+		assert.Equal(t, ErrPointerInvalid, err)
+	})
+}
+
+// TestGetAllEscaping tests escaped literal segments next to wildcards.
+func TestGetAllEscaping(t *testing.T) {
+	doc := map[string]any{
+		"a/b": map[string]any{"x": 1, "y": 2},
+		"c~d": []any{map[string]any{"e/f": 3}},
+		"*":   map[string]any{"star": 4},
+		"**":  5,
+		"a*":  6,
+	}
+
+	tests := []struct {
+		pattern  string
+		expected []any
+	}{
+		{"/a~1b/*", []any{1, 2}},
+		{"/c~0d/*/e~1f", []any{3}},
+		{"/*/0/e~1f", []any{3}},
+		{"/**/e~1f", []any{3}},
+		{"/~*/star", []any{4}},
+		{"/~*~*", []any{5}},
+		{"/a*", []any{6}},
+		{"/a~*", []any{6}},
+		{"/**/star", []any{4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			refs, err := GetAll(doc, tt.pattern)
+			assert.NoError(t, err)
+			vals := make([]any, len(refs))
+			for i, ref := range refs {
+				vals[i] = ref.Val
+			}
+			assert.Equal(t, tt.expected, vals)
+		})
+	}
+
+	t.Run("literal star key is unescaped in references", func(t *testing.T) {
+		refs, err := GetAll(doc, "/~*")
+		assert.NoError(t, err)
+		assert.Len(t, refs, 1)
+		assert.Equal(t, "*", refs[0].Key)
+	})
+
+	t.Run("invalid escapes", func(t *testing.T) {
+		for _, pattern := range []string{"/a~", "/a~2/*", "/*/~x"} {
+			_, err := GetAll(doc, pattern)
+			assert.Equal(t, ErrPointerInvalid, err, pattern)
+		}
+	})
+}
+
+// TestCountMatches tests counting wildcard pattern matches.
+func TestCountMatches(t *testing.T) {
+	doc := wildcardDoc()
+
+	tests := []struct {
+		pattern  string
+		expected int
+	}{
+		{"/users/*", 3},
+		{"/users/*/name", 3},
+		{"/users/*/tags/*", 1},
+		{"/**/name", 4},
+		{"/missing/*", 0},
+		{"/meta/version/*", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			count, err := CountMatches(doc, tt.pattern)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, count)
+
+			refs, err := GetAll(doc, tt.pattern)
+			assert.NoError(t, err)
+			assert.Len(t, refs, count)
+		})
+	}
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := CountMatches(doc, "/a~2")
+		assert.Equal(t, ErrPointerInvalid, err)
+	})
+}
+
+func TestFindOne(t *testing.T) {
+	doc := wildcardDoc()
+
+	t.Run("single match", func(t *testing.T) {
+		ref, err := FindOne(doc, "/users/*/tags/*")
+		assert.NoError(t, err)
+		assert.Equal(t, "admin", ref.Val)
+		assert.Equal(t, "0", ref.Key)
+		assert.Equal(t, []any{"admin"}, ref.Obj)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		ref, err := FindOne(doc, "/missing/*")
+		assert.Equal(t, ErrNotFound, err)
+		assert.Nil(t, ref)
+	})
+
+	t.Run("multiple matches", func(t *testing.T) {
+		ref, err := FindOne(doc, "/users/*/name")
+		assert.Equal(t, ErrMultipleMatches, err)
+		assert.Nil(t, ref)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := FindOne(doc, "users/*")
+		assert.Equal(t, ErrPointerInvalid, err)
+	})
+}
+
+func TestCompilePattern(t *testing.T) {
+	doc := wildcardDoc()
+
+	for _, pattern := range []string{"/users/*/name", "/**/name", "/users/*/tags/*", "/missing/*", ""} {
+		t.Run(pattern, func(t *testing.T) {
+			p, err := CompilePattern(pattern)
+			assert.NoError(t, err)
+			assert.Equal(t, pattern, p.String())
+
+			expected, err := GetAll(doc, pattern)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, p.Match(doc))
+		})
+	}
+
+	t.Run("reuse across documents", func(t *testing.T) {
+		p, err := CompilePattern("/event/~*/id")
+		assert.NoError(t, err)
+		for i := range 3 {
+			refs := p.Match(map[string]any{"event": map[string]any{"*": map[string]any{"id": i}}})
+			assert.Len(t, refs, 1)
+			assert.Equal(t, i, refs[0].Val)
+		}
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		p, err := CompilePattern("users/*")
+		assert.Equal(t, ErrPointerInvalid, err)
+		assert.Nil(t, p)
+	})
+}